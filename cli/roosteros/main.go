@@ -0,0 +1,82 @@
+// roosteros is the umbrella CLI for build/profile tooling. Today it
+// only wraps the profile subcommands; system_info remains its own
+// standalone tool under 07 tools.
+//
+// Usage:
+//   roosteros profile schema
+//   roosteros profile validate <profile.toml> [package-index.toml]
+
+package main
+
+import (
+    "encoding/json"
+    "fmt"
+    "os"
+
+    profile "github.com/DrAhmadRouhimaleh/RoosterOS/configs/medium"
+)
+
+func main() {
+    if len(os.Args) < 2 {
+        usage()
+    }
+    switch os.Args[1] {
+    case "profile":
+        runProfile(os.Args[2:])
+    default:
+        usage()
+    }
+}
+
+func usage() {
+    fmt.Fprintln(os.Stderr, "usage: roosteros profile {schema|validate <profile.toml> [package-index.toml]}")
+    os.Exit(2)
+}
+
+func runProfile(args []string) {
+    if len(args) < 1 {
+        usage()
+    }
+    switch args[0] {
+    case "schema":
+        enc := json.NewEncoder(os.Stdout)
+        enc.SetIndent("", "  ")
+        if err := enc.Encode(profile.JSONSchema()); err != nil {
+            fmt.Fprintln(os.Stderr, err)
+            os.Exit(1)
+        }
+    case "validate":
+        if len(args) < 2 {
+            usage()
+        }
+        // profile.Load must successfully parse and Validate p before any
+        // dependency resolution below can run; a profile that fails to
+        // decode (e.g. a malformed gfx_min) never reaches ResolveDependencies.
+        p, err := profile.Load(args[1])
+        if err != nil {
+            fmt.Fprintln(os.Stderr, "invalid profile:", err)
+            os.Exit(1)
+        }
+        fmt.Printf("ok: %s/%s profile, schema_version %s\n", p.Edition, p.Arch, p.SchemaVersion)
+
+        // A package-index argument additionally resolves every entry in
+        // p.Packages against it, so `validate` catches dependency
+        // cycles, conflicts, arch mismatches, and disk-size overruns
+        // before they reach an install.
+        if len(args) >= 3 {
+            resolver, err := profile.NewTOMLIndexResolver(args[2])
+            if err != nil {
+                fmt.Fprintln(os.Stderr, "loading package index:", err)
+                os.Exit(1)
+            }
+            report, err := p.ResolveDependencies(resolver)
+            if err != nil {
+                fmt.Fprintln(os.Stderr, "dependency resolution failed:", err)
+                os.Exit(1)
+            }
+            fmt.Printf("ok: resolved %d packages, %dMB install size\n", len(report.Order), report.InstallSizeMB)
+        }
+    default:
+        usage()
+    }
+}
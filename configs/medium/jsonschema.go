@@ -0,0 +1,71 @@
+package profile
+
+import "sort"
+
+// JSONSchema returns a JSON Schema (draft 2020-12) document describing
+// Profile, for editor integration and the `roosteros profile schema`
+// subcommand. It's built by hand rather than reflected off the struct
+// tags so the enums and cross-field notes stay in sync with Validate.
+func JSONSchema() map[string]interface{} {
+    return map[string]interface{}{
+        "$schema": "https://json-schema.org/draft/2020-12/schema",
+        "$id":     "https://roosteros.dev/schema/profile.json",
+        "title":   "RoosterOS hardware profile",
+        "type":    "object",
+        "required": []string{"edition", "arch", "ram_min_mb", "ram_max_mb", "cpu_min_mhz", "gfx_min", "disk_min_gb"},
+        "properties": map[string]interface{}{
+            "schema_version": map[string]interface{}{
+                "type":        "string",
+                "description": "Profile schema version; auto-upgraded on Load if omitted.",
+                "default":     CurrentSchemaVersion,
+            },
+            "edition": map[string]interface{}{
+                "type": "string",
+                "enum": sortedKeys(editions),
+            },
+            "arch": map[string]interface{}{
+                "type": "string",
+                "enum": sortedKeys(arches),
+            },
+            "ram_min_mb": map[string]interface{}{
+                "type": "integer", "minimum": 16, "maximum": 65536,
+            },
+            "ram_max_mb": map[string]interface{}{
+                "type":        "integer",
+                "maximum":     65536,
+                "description": "Must be >= ram_min_mb.",
+            },
+            "cpu_min_mhz": map[string]interface{}{
+                "type": "integer", "minimum": 100,
+            },
+            "cpu_max_mhz": map[string]interface{}{
+                "type":        "integer",
+                "maximum":     10000,
+                "description": "Defaults to cpu_min_mhz if omitted; must be >= cpu_min_mhz.",
+            },
+            "gfx_min": map[string]interface{}{
+                "type":        "string",
+                "pattern":     `^\S+ (\d+x\d+|\S+)$`,
+                "description": `"<vendor> <WxH>" (min 320x200) or "<vendor> <feature>".`,
+            },
+            "disk_min_gb": map[string]interface{}{
+                "type": "number", "minimum": 1,
+            },
+            "internet": map[string]interface{}{"type": "boolean"},
+            "packages": map[string]interface{}{
+                "type":    "array",
+                "items":   map[string]interface{}{"type": "string", "pattern": pkgRe.String()},
+                "description": "Deduplicated when profiles are composed with LoadWithOverlays.",
+            },
+        },
+    }
+}
+
+func sortedKeys(m map[string]bool) []string {
+    keys := make([]string, 0, len(m))
+    for k := range m {
+        keys = append(keys, k)
+    }
+    sort.Strings(keys)
+    return keys
+}
@@ -0,0 +1,95 @@
+package profile
+
+import (
+    "fmt"
+    "os"
+
+    "github.com/pelletier/go-toml/v2"
+)
+
+// LoadWithOverlays deep-merges base and overlays, in order, into a
+// single Profile: scalar and table keys in a later file replace the
+// same key in an earlier one, and "packages" lists concatenate with
+// duplicates removed. This lets a deployment compose
+// base.toml + arch/x86_64.toml + edition/server.toml the way container
+// image build configs are layered.
+func LoadWithOverlays(base string, overlays ...string) (*Profile, error) {
+    merged, err := loadRawTOML(base)
+    if err != nil {
+        return nil, fmt.Errorf("profile: loading base %s: %w", base, err)
+    }
+    for _, path := range overlays {
+        layer, err := loadRawTOML(path)
+        if err != nil {
+            return nil, fmt.Errorf("profile: loading overlay %s: %w", path, err)
+        }
+        merged = mergeRaw(merged, layer)
+    }
+
+    if err := upgradeProfile(merged); err != nil {
+        return nil, err
+    }
+    data, err := toml.Marshal(merged)
+    if err != nil {
+        return nil, err
+    }
+    var p Profile
+    if err := toml.Unmarshal(data, &p); err != nil {
+        return nil, err
+    }
+    if err := p.Validate(); err != nil {
+        return nil, err
+    }
+    return &p, nil
+}
+
+func loadRawTOML(path string) (map[string]interface{}, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, err
+    }
+    var raw map[string]interface{}
+    if err := toml.Unmarshal(data, &raw); err != nil {
+        return nil, err
+    }
+    return raw, nil
+}
+
+// mergeRaw merges overlay onto base and returns base. overlay wins on
+// scalar and table conflicts; the "packages" key is concatenated and
+// deduplicated instead of replaced.
+func mergeRaw(base, overlay map[string]interface{}) map[string]interface{} {
+    for k, v := range overlay {
+        if k == "packages" {
+            base[k] = mergePackages(base[k], v)
+            continue
+        }
+        if baseTable, ok := base[k].(map[string]interface{}); ok {
+            if overlayTable, ok := v.(map[string]interface{}); ok {
+                base[k] = mergeRaw(baseTable, overlayTable)
+                continue
+            }
+        }
+        base[k] = v
+    }
+    return base
+}
+
+func mergePackages(base, overlay interface{}) []string {
+    seen := map[string]bool{}
+    var out []string
+    add := func(v interface{}) {
+        list, _ := v.([]interface{})
+        for _, item := range list {
+            name, ok := item.(string)
+            if !ok || seen[name] {
+                continue
+            }
+            seen[name] = true
+            out = append(out, name)
+        }
+    }
+    add(base)
+    add(overlay)
+    return out
+}
@@ -0,0 +1,28 @@
+package profile
+
+import (
+    "reflect"
+    "testing"
+)
+
+func TestMergeRaw(t *testing.T) {
+    base := map[string]interface{}{
+        "edition":  "low",
+        "packages": []interface{}{"bash", "curl"},
+    }
+    overlay := map[string]interface{}{
+        "edition":  "server",
+        "packages": []interface{}{"curl", "openssh"},
+    }
+
+    merged := mergeRaw(base, overlay)
+
+    if merged["edition"] != "server" {
+        t.Errorf("edition = %v, want overlay's value %q", merged["edition"], "server")
+    }
+
+    want := []string{"bash", "curl", "openssh"}
+    if got, ok := merged["packages"].([]string); !ok || !reflect.DeepEqual(got, want) {
+        t.Errorf("packages = %v, want %v (concatenated, deduplicated, base-then-overlay order)", merged["packages"], want)
+    }
+}
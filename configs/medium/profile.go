@@ -0,0 +1,194 @@
+package profile
+
+import (
+    "fmt"
+    "os"
+    "regexp"
+    "strings"
+
+    "github.com/pelletier/go-toml/v2"
+
+    "github.com/DrAhmadRouhimaleh/RoosterOS/tools/sysinfo"
+)
+
+var (
+    editions = map[string]bool{"low": true, "medium": true, "high": true, "server": true}
+    arches   = map[string]bool{"x86": true, "x86_64": true, "arm": true, "arm64": true, "riscv": true}
+    pkgRe    = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]*$`)
+    resRe    = regexp.MustCompile(`^(\d+)x(\d+)$`)
+)
+
+// Graphics holds parsed gfx_min.
+type Graphics struct {
+    Vendor  string
+    Width   int    // >0 if resolution parsed
+    Height  int    // >0 if resolution parsed
+    Feature string // driver/feature if not resolution
+}
+
+// UnmarshalText customizes parsing of gfx_min. go-toml/v2 invokes this
+// via the standard encoding.TextUnmarshaler interface for any TOML
+// string value, with no decoder opt-in required (unlike its unstable
+// UnmarshalTOML hook).
+func (g *Graphics) UnmarshalText(text []byte) error {
+    s := string(text)
+    parts := strings.SplitN(s, " ", 2)
+    if len(parts) != 2 {
+        return fmt.Errorf("invalid gfx_min: %q", s)
+    }
+    g.Vendor = parts[0]
+    spec := parts[1]
+
+    if m := resRe.FindStringSubmatch(spec); m != nil {
+        // resolution path
+        fmt.Sscanf(m[1], "%d", &g.Width)
+        fmt.Sscanf(m[2], "%d", &g.Height)
+        if g.Width < 320 || g.Height < 200 {
+            return fmt.Errorf("resolution %dx%d too small (min 320x200)", g.Width, g.Height)
+        }
+    } else {
+        // feature path
+        g.Feature = spec
+    }
+    return nil
+}
+
+// Profile defines the hardware config.
+type Profile struct {
+    SchemaVersion string   `toml:"schema_version,omitempty"`
+    Edition       string   `toml:"edition"`
+    Arch          string   `toml:"arch"`
+    RAMMinMB      int      `toml:"ram_min_mb"`
+    RAMMaxMB      int      `toml:"ram_max_mb"`
+    CPUMinMHz     int      `toml:"cpu_min_mhz"`
+    CPUMaxMHz     int      `toml:"cpu_max_mhz,omitempty"` // optional
+    GFXMin        Graphics `toml:"gfx_min"`
+    DiskMinGB     float64  `toml:"disk_min_gb"`
+    Internet      bool     `toml:"internet"`
+    Packages      []string `toml:"packages"`
+}
+
+// Validate checks all fields and cross-field constraints.
+func (p *Profile) Validate() error {
+    if !editions[p.Edition] {
+        return fmt.Errorf("edition %q not allowed", p.Edition)
+    }
+    if !arches[p.Arch] {
+        return fmt.Errorf("arch %q not allowed", p.Arch)
+    }
+    if p.RAMMinMB < 16 || p.RAMMaxMB < p.RAMMinMB || p.RAMMaxMB > 65536 {
+        return fmt.Errorf("invalid RAM range %d–%d (allowed 16–65536)", p.RAMMinMB, p.RAMMaxMB)
+    }
+    if p.CPUMinMHz < 100 {
+        return fmt.Errorf("cpu_min_mhz %d too low (min 100)", p.CPUMinMHz)
+    }
+    if p.CPUMaxMHz == 0 {
+        p.CPUMaxMHz = p.CPUMinMHz
+    }
+    if p.CPUMaxMHz < p.CPUMinMHz || p.CPUMaxMHz > 10000 {
+        return fmt.Errorf("invalid CPU range %d–%d (allowed ≤10000)", p.CPUMinMHz, p.CPUMaxMHz)
+    }
+    if p.GFXMin.Vendor == "" {
+        return fmt.Errorf("gfx_min is required")
+    }
+    if p.DiskMinGB < 1 {
+        return fmt.Errorf("disk_min_gb %.1f too small (min 1)", p.DiskMinGB)
+    }
+    for _, pkg := range p.Packages {
+        if !pkgRe.MatchString(pkg) {
+            return fmt.Errorf("invalid package name %q", pkg)
+        }
+    }
+    return nil
+}
+
+// ValidateAgainstHost cross-checks the profile's minimums against the
+// machine actually running it, using c rather than re-parsing /proc or
+// other OS-specific files directly.
+func (p *Profile) ValidateAgainstHost(c sysinfo.Collector) error {
+    mem, err := c.Mem()
+    if err != nil {
+        return fmt.Errorf("sysinfo: mem: %w", err)
+    }
+    if mem.EffectiveLimit/1024/1024 < uint64(p.RAMMinMB) {
+        return fmt.Errorf("host has %dMB RAM, profile requires %dMB", mem.EffectiveLimit/1024/1024, p.RAMMinMB)
+    }
+    cpu, err := c.CPU()
+    if err != nil {
+        return fmt.Errorf("sysinfo: cpu: %w", err)
+    }
+    if int(cpu.MHz) < p.CPUMinMHz {
+        return fmt.Errorf("host CPU runs at %.0fMHz, profile requires %dMHz", cpu.MHz, p.CPUMinMHz)
+    }
+    disks, err := c.Disks()
+    if err != nil {
+        return fmt.Errorf("sysinfo: disks: %w", err)
+    }
+    var totalGB float64
+    for _, d := range disks {
+        totalGB += float64(d.Total) / 1e9
+    }
+    if totalGB < p.DiskMinGB {
+        return fmt.Errorf("host has %.1fGB disk, profile requires %.1fGB", totalGB, p.DiskMinGB)
+    }
+    if p.Edition == "server" {
+        for _, d := range disks {
+            if d.SmartOK && d.PercentUsed >= diskEndOfLifePercent {
+                return fmt.Errorf("disk %s is %d%% worn (SMART/NVMe life used), too close to end-of-life for server edition", d.MountPoint, d.PercentUsed)
+            }
+            if d.SmartOK && !smartHealthy(d) {
+                return fmt.Errorf("disk %s failed SMART/NVMe health check", d.MountPoint)
+            }
+        }
+    }
+    return nil
+}
+
+// diskEndOfLifePercent is the SMART/NVMe "percentage used" threshold
+// above which server edition refuses a disk.
+const diskEndOfLifePercent = 90
+
+// smartHealthy reports whether d's reported SMART/NVMe health leaves
+// room for the server edition's reliability bar.
+func smartHealthy(d sysinfo.DiskInfo) bool {
+    return d.MediaErrors == 0
+}
+
+// Load reads, parses, and validates a TOML profile, upgrading it to
+// CurrentSchemaVersion first if it was written by an older version of
+// RoosterOS.
+func Load(path string) (*Profile, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, err
+    }
+    p, err := parseProfile(data)
+    if err != nil {
+        return nil, err
+    }
+    if err := p.Validate(); err != nil {
+        return nil, err
+    }
+    return p, nil
+}
+
+// parseProfile decodes raw TOML into a map, runs it through the
+// upgrader chain, then unmarshals the result into a Profile.
+func parseProfile(data []byte) (*Profile, error) {
+    var raw map[string]interface{}
+    if err := toml.Unmarshal(data, &raw); err != nil {
+        return nil, err
+    }
+    if err := upgradeProfile(raw); err != nil {
+        return nil, err
+    }
+    upgraded, err := toml.Marshal(raw)
+    if err != nil {
+        return nil, err
+    }
+    var p Profile
+    if err := toml.Unmarshal(upgraded, &p); err != nil {
+        return nil, err
+    }
+    return &p, nil
+}
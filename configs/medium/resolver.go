@@ -0,0 +1,195 @@
+package profile
+
+import (
+    "errors"
+    "fmt"
+)
+
+// PkgMeta is everything the dependency resolver needs to know about a
+// single package.
+type PkgMeta struct {
+    Name            string
+    Version         string
+    Depends         []string
+    Conflicts       []string
+    Provides        []string
+    InstallSizeKB   uint64
+    SupportedArches []string
+}
+
+// PackageResolver looks up package metadata by name. The default
+// implementations read a repository index file; tests or alternate
+// package managers can supply their own.
+type PackageResolver interface {
+    Resolve(name string) (*PkgMeta, error)
+}
+
+// DependencyReport is the result of resolving a profile's package list
+// and its transitive closure.
+type DependencyReport struct {
+    // Order is a valid install order (dependencies before dependents),
+    // populated only when there are no cycles.
+    Order []string
+    // Cycles lists each dependency cycle found, as the chain of
+    // package names that leads back to itself.
+    Cycles [][]string
+    // Conflicts lists human-readable descriptions of packages in the
+    // closure that declare a conflict with one another.
+    Conflicts []string
+    // ArchMismatches lists packages in the closure that don't support
+    // the profile's arch.
+    ArchMismatches []string
+    // InstallSizeMB is the aggregate installed size of every unique
+    // package in the closure.
+    InstallSizeMB int
+}
+
+// Err returns a single error summarizing every problem in the report,
+// or nil if resolution was clean.
+func (r *DependencyReport) Err() error {
+    var errs []error
+    for _, cycle := range r.Cycles {
+        errs = append(errs, fmt.Errorf("dependency cycle: %s", joinChain(cycle)))
+    }
+    for _, c := range r.Conflicts {
+        errs = append(errs, errors.New(c))
+    }
+    for _, m := range r.ArchMismatches {
+        errs = append(errs, errors.New(m))
+    }
+    if len(errs) == 0 {
+        return nil
+    }
+    return errors.Join(errs...)
+}
+
+func joinChain(chain []string) string {
+    out := ""
+    for i, name := range chain {
+        if i > 0 {
+            out += " -> "
+        }
+        out += name
+    }
+    return out
+}
+
+// ResolveDependencies resolves every package in p.Packages (and their
+// transitive Depends) via r, checks for cycles and unsatisfiable
+// conflicts, and cross-checks the aggregate install size against
+// p.DiskMinGB and each package's SupportedArches against p.Arch.
+func (p *Profile) ResolveDependencies(r PackageResolver) (*DependencyReport, error) {
+    report := &DependencyReport{}
+    resolved := map[string]*PkgMeta{}
+
+    var resolveClosure func(name string, chain []string) error
+    resolveClosure = func(name string, chain []string) error {
+        for _, seen := range chain {
+            if seen == name {
+                report.Cycles = append(report.Cycles, append(append([]string{}, chain...), name))
+                return nil
+            }
+        }
+        if _, ok := resolved[name]; ok {
+            return nil
+        }
+        meta, err := r.Resolve(name)
+        if err != nil {
+            return fmt.Errorf("resolving package %q: %w", name, err)
+        }
+        resolved[name] = meta
+        for _, dep := range meta.Depends {
+            if err := resolveClosure(dep, append(chain, name)); err != nil {
+                return err
+            }
+        }
+        return nil
+    }
+
+    for _, pkg := range p.Packages {
+        if err := resolveClosure(pkg, nil); err != nil {
+            return nil, err
+        }
+    }
+
+    // Conflict check: any two resolved packages (by name or by a
+    // Provides alias) that declare each other incompatible.
+    provides := map[string]string{} // provided name/alias -> owning package
+    for name, meta := range resolved {
+        provides[name] = name
+        for _, p := range meta.Provides {
+            provides[p] = name
+        }
+    }
+    for name, meta := range resolved {
+        for _, conflict := range meta.Conflicts {
+            if owner, ok := provides[conflict]; ok {
+                report.Conflicts = append(report.Conflicts,
+                    fmt.Sprintf("package %q conflicts with %q (provided by %q)", name, conflict, owner))
+            }
+        }
+    }
+
+    // Arch check.
+    for name, meta := range resolved {
+        if !supportsArch(meta, p.Arch) {
+            report.ArchMismatches = append(report.ArchMismatches,
+                fmt.Sprintf("package %q does not support arch %q", name, p.Arch))
+        }
+    }
+
+    // Aggregate size.
+    var totalKB uint64
+    for _, meta := range resolved {
+        totalKB += meta.InstallSizeKB
+    }
+    report.InstallSizeMB = int(totalKB / 1024)
+    if float64(report.InstallSizeMB) > p.DiskMinGB*1024 {
+        report.Conflicts = append(report.Conflicts, fmt.Sprintf(
+            "aggregate install size %dMB exceeds disk_min_gb (%.1fGB)", report.InstallSizeMB, p.DiskMinGB))
+    }
+
+    // Topological order (Kahn's algorithm), skipped if any cycle was found.
+    if len(report.Cycles) == 0 {
+        report.Order = topoSort(resolved)
+    }
+
+    return report, report.Err()
+}
+
+func supportsArch(meta *PkgMeta, arch string) bool {
+    if len(meta.SupportedArches) == 0 {
+        return true // no restriction declared
+    }
+    for _, a := range meta.SupportedArches {
+        if a == arch {
+            return true
+        }
+    }
+    return false
+}
+
+// topoSort orders resolved so every package's Depends appear before
+// it. Callers must already know the graph is acyclic.
+func topoSort(resolved map[string]*PkgMeta) []string {
+    visited := map[string]bool{}
+    var order []string
+
+    var visit func(name string)
+    visit = func(name string) {
+        if visited[name] {
+            return
+        }
+        visited[name] = true
+        if meta, ok := resolved[name]; ok {
+            for _, dep := range meta.Depends {
+                visit(dep)
+            }
+        }
+        order = append(order, name)
+    }
+    for name := range resolved {
+        visit(name)
+    }
+    return order
+}
@@ -0,0 +1,133 @@
+package profile
+
+import (
+    "bufio"
+    "fmt"
+    "os"
+    "strings"
+
+    "github.com/pelletier/go-toml/v2"
+)
+
+// tomlIndexEntry is the on-disk shape of one package in a TOML
+// repository index (see NewTOMLIndexResolver).
+type tomlIndexEntry struct {
+    Version         string   `toml:"version"`
+    Depends         []string `toml:"depends"`
+    Conflicts       []string `toml:"conflicts"`
+    Provides        []string `toml:"provides"`
+    InstallSizeKB   uint64   `toml:"install_size_kb"`
+    SupportedArches []string `toml:"arches"`
+}
+
+// tomlIndexResolver resolves packages from a `[packages.<name>]`-keyed
+// TOML repository index, RoosterOS's native format.
+type tomlIndexResolver struct {
+    packages map[string]tomlIndexEntry
+}
+
+// NewTOMLIndexResolver loads a repository index in RoosterOS's native
+// TOML format:
+//
+//	[packages.bash]
+//	version = "5.2"
+//	depends = ["readline"]
+//	install_size_kb = 4200
+//	arches = ["x86_64", "arm64"]
+func NewTOMLIndexResolver(path string) (PackageResolver, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, err
+    }
+    var doc struct {
+        Packages map[string]tomlIndexEntry `toml:"packages"`
+    }
+    if err := toml.Unmarshal(data, &doc); err != nil {
+        return nil, fmt.Errorf("profile: parsing package index %s: %w", path, err)
+    }
+    return &tomlIndexResolver{packages: doc.Packages}, nil
+}
+
+func (r *tomlIndexResolver) Resolve(name string) (*PkgMeta, error) {
+    e, ok := r.packages[name]
+    if !ok {
+        return nil, fmt.Errorf("package %q not found in index", name)
+    }
+    return &PkgMeta{
+        Name:            name,
+        Version:         e.Version,
+        Depends:         e.Depends,
+        Conflicts:       e.Conflicts,
+        Provides:        e.Provides,
+        InstallSizeKB:   e.InstallSizeKB,
+        SupportedArches: e.SupportedArches,
+    }, nil
+}
+
+// apkIndexResolver resolves packages from an Alpine-style APKINDEX:
+// records are blank-line-separated stanzas of "K:value" lines (P:name,
+// V:version, D:depends, i:provides, S:size in bytes). Alpine's index
+// has no native "conflicts" or "arches" field, so those come back
+// empty — RoosterOS treats an apk index as informational only, not a
+// substitute for the server-edition SMART/arch checks above it.
+type apkIndexResolver struct {
+    packages map[string]PkgMeta
+}
+
+// NewAPKIndexResolver loads a repository index in the flat APKINDEX
+// format used by apk (and, in spirit, pacman's per-package desc
+// files).
+func NewAPKIndexResolver(path string) (PackageResolver, error) {
+    file, err := os.Open(path)
+    if err != nil {
+        return nil, err
+    }
+    defer file.Close()
+
+    packages := map[string]PkgMeta{}
+    var cur PkgMeta
+    flush := func() {
+        if cur.Name != "" {
+            packages[cur.Name] = cur
+        }
+        cur = PkgMeta{}
+    }
+
+    scanner := bufio.NewScanner(file)
+    for scanner.Scan() {
+        line := scanner.Text()
+        if line == "" {
+            flush()
+            continue
+        }
+        if len(line) < 2 || line[1] != ':' {
+            continue
+        }
+        key, val := line[0], line[2:]
+        switch key {
+        case 'P':
+            cur.Name = val
+        case 'V':
+            cur.Version = val
+        case 'D':
+            cur.Depends = strings.Fields(val)
+        case 'i':
+            cur.Provides = strings.Fields(val)
+        case 'S':
+            fmt.Sscanf(val, "%d", &cur.InstallSizeKB)
+            cur.InstallSizeKB /= 1024
+        }
+    }
+    flush()
+
+    return &apkIndexResolver{packages: packages}, nil
+}
+
+func (r *apkIndexResolver) Resolve(name string) (*PkgMeta, error) {
+    e, ok := r.packages[name]
+    if !ok {
+        return nil, fmt.Errorf("package %q not found in APKINDEX", name)
+    }
+    meta := e
+    return &meta, nil
+}
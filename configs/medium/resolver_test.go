@@ -0,0 +1,90 @@
+package profile
+
+import (
+    "fmt"
+    "testing"
+)
+
+// fakeResolver resolves packages from an in-memory map, for testing
+// ResolveDependencies without a real repository index file.
+type fakeResolver map[string]*PkgMeta
+
+func (f fakeResolver) Resolve(name string) (*PkgMeta, error) {
+    meta, ok := f[name]
+    if !ok {
+        return nil, fmt.Errorf("package %q not found", name)
+    }
+    return meta, nil
+}
+
+func TestResolveDependencies(t *testing.T) {
+    tests := []struct {
+        name        string
+        profile     Profile
+        resolver    fakeResolver
+        wantErr     bool
+        wantCycle   bool
+        wantConflict bool
+        wantOrder   []string // unordered check: every name must appear
+    }{
+        {
+            name:    "cycle is detected and reported",
+            profile: Profile{Packages: []string{"a"}, Arch: "x86_64", DiskMinGB: 100},
+            resolver: fakeResolver{
+                "a": {Name: "a", Depends: []string{"b"}},
+                "b": {Name: "b", Depends: []string{"a"}},
+            },
+            wantErr:   true,
+            wantCycle: true,
+        },
+        {
+            name:    "diamond dependency is not flagged as a cycle",
+            profile: Profile{Packages: []string{"a"}, Arch: "x86_64", DiskMinGB: 100},
+            resolver: fakeResolver{
+                "a": {Name: "a", Depends: []string{"b", "c"}},
+                "b": {Name: "b", Depends: []string{"d"}},
+                "c": {Name: "c", Depends: []string{"d"}},
+                "d": {Name: "d"},
+            },
+            wantErr:   false,
+            wantOrder: []string{"a", "b", "c", "d"},
+        },
+        {
+            name:    "conflict via a Provides alias is reported",
+            profile: Profile{Packages: []string{"x", "y"}, Arch: "x86_64", DiskMinGB: 100},
+            resolver: fakeResolver{
+                "x": {Name: "x", Conflicts: []string{"libfoo"}},
+                "y": {Name: "y", Provides: []string{"libfoo"}},
+            },
+            wantErr:      true,
+            wantConflict: true,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            report, err := tt.profile.ResolveDependencies(tt.resolver)
+            if (err != nil) != tt.wantErr {
+                t.Fatalf("ResolveDependencies() error = %v, wantErr %v", err, tt.wantErr)
+            }
+            if tt.wantCycle && len(report.Cycles) == 0 {
+                t.Errorf("expected a reported cycle, got none")
+            }
+            if tt.wantConflict && len(report.Conflicts) == 0 {
+                t.Errorf("expected a reported conflict, got none")
+            }
+            if tt.wantOrder != nil {
+                if len(report.Order) != len(tt.wantOrder) {
+                    t.Fatalf("Order = %v, want all of %v", report.Order, tt.wantOrder)
+                }
+                pos := map[string]int{}
+                for i, name := range report.Order {
+                    pos[name] = i
+                }
+                if pos["d"] > pos["b"] || pos["d"] > pos["c"] || pos["b"] > pos["a"] || pos["c"] > pos["a"] {
+                    t.Errorf("Order = %v, want d before b and c, and a last", report.Order)
+                }
+            }
+        })
+    }
+}
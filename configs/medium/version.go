@@ -0,0 +1,77 @@
+package profile
+
+import "fmt"
+
+// CurrentSchemaVersion is the schema_version every Profile written by
+// this build of RoosterOS carries. Older files are run through
+// upgraders (below) before being unmarshaled.
+const CurrentSchemaVersion = "1.1.0"
+
+// upgrader transforms a raw, still-untyped TOML document from its
+// fromVersion to toVersion. Raw stays a map[string]interface{} (rather
+// than the typed Profile) so an upgrader can read fields that no
+// longer exist in the current struct.
+type upgrader struct {
+    fromVersion string
+    toVersion   string
+    apply       func(raw map[string]interface{}) error
+}
+
+// upgraders lists the chain in order; upgradeProfile walks it starting
+// from whatever version the document declares (or "1.0.0" if absent).
+var upgraders = []upgrader{
+    {
+        fromVersion: "1.0.0",
+        toVersion:   "1.1.0",
+        apply:       upgrade100to110,
+    },
+}
+
+// upgrade100to110 migrates the pre-1.1.0 separate gfx_min_vendor /
+// gfx_min_w / gfx_min_h keys into the combined "vendor WxH" gfx_min
+// string Graphics.UnmarshalText expects.
+func upgrade100to110(raw map[string]interface{}) error {
+    vendor, hasVendor := raw["gfx_min_vendor"]
+    w, hasW := raw["gfx_min_w"]
+    h, hasH := raw["gfx_min_h"]
+    if !hasVendor && !hasW && !hasH {
+        return nil // nothing to migrate
+    }
+    if !hasVendor || !hasW || !hasH {
+        return fmt.Errorf("profile: incomplete 1.0.0 gfx_min_* fields")
+    }
+    raw["gfx_min"] = fmt.Sprintf("%v %vx%v", vendor, w, h)
+    delete(raw, "gfx_min_vendor")
+    delete(raw, "gfx_min_w")
+    delete(raw, "gfx_min_h")
+    return nil
+}
+
+// upgradeProfile runs raw through every applicable upgrader and stamps
+// the result with CurrentSchemaVersion.
+func upgradeProfile(raw map[string]interface{}) error {
+    version, _ := raw["schema_version"].(string)
+    if version == "" {
+        version = "1.0.0"
+    }
+
+    for version != CurrentSchemaVersion {
+        var next *upgrader
+        for i := range upgraders {
+            if upgraders[i].fromVersion == version {
+                next = &upgraders[i]
+                break
+            }
+        }
+        if next == nil {
+            return fmt.Errorf("profile: no upgrader from schema_version %q to %q", version, CurrentSchemaVersion)
+        }
+        if err := next.apply(raw); err != nil {
+            return fmt.Errorf("profile: upgrading from %s to %s: %w", next.fromVersion, next.toVersion, err)
+        }
+        version = next.toVersion
+    }
+
+    raw["schema_version"] = CurrentSchemaVersion
+    return nil
+}
@@ -0,0 +1,34 @@
+package profile
+
+import "testing"
+
+func TestUpgradeProfile(t *testing.T) {
+    raw := map[string]interface{}{
+        "gfx_min_vendor": "generic",
+        "gfx_min_w":      "640",
+        "gfx_min_h":      "480",
+    }
+
+    if err := upgradeProfile(raw); err != nil {
+        t.Fatalf("upgradeProfile() error = %v", err)
+    }
+
+    if raw["schema_version"] != CurrentSchemaVersion {
+        t.Errorf("schema_version = %v, want %v", raw["schema_version"], CurrentSchemaVersion)
+    }
+    if raw["gfx_min"] != "generic 640x480" {
+        t.Errorf("gfx_min = %v, want %q", raw["gfx_min"], "generic 640x480")
+    }
+    for _, stale := range []string{"gfx_min_vendor", "gfx_min_w", "gfx_min_h"} {
+        if _, present := raw[stale]; present {
+            t.Errorf("%s should have been removed by the upgrader", stale)
+        }
+    }
+}
+
+func TestUpgradeProfileIncomplete(t *testing.T) {
+    raw := map[string]interface{}{"gfx_min_vendor": "generic"}
+    if err := upgradeProfile(raw); err == nil {
+        t.Fatal("upgradeProfile() with partial 1.0.0 gfx_min_* fields: want error, got nil")
+    }
+}
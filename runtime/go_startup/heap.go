@@ -0,0 +1,18 @@
+//go:build baremetal
+
+package main
+
+import "github.com/DrAhmadRouhimaleh/RoosterOS/runtime/go_startup/memshim"
+
+// initHeap hands the physical RAM regions discovered from the
+// Multiboot MMAP tag to memshim, which the vendored runtime fork's
+// mem_baremetal.go (runtime_baremetal tag) forwards sysAlloc/sysFree/
+// sysReserve/sysMap into.
+//go:nosplit
+func initHeap(regions []memRegion) {
+    shimRegions := make([]memshim.Region, len(regions))
+    for i, r := range regions {
+        shimRegions[i] = memshim.Region{Start: r.Start, End: r.End}
+    }
+    memshim.Init(shimRegions)
+}
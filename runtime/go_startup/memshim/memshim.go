@@ -0,0 +1,92 @@
+//go:build baremetal
+
+// Package memshim is a bump-pointer physical memory allocator that
+// backs the Go heap on bare metal.
+//
+// It intentionally mirrors the signatures of sysAlloc/sysFree/
+// sysReserve/sysMap in runtime/mem_*.go rather than reaching into the
+// runtime package with //go:linkname against unexported symbols (the
+// approach this replaced, which referenced a runtime.initMem that
+// doesn't exist upstream). To actually back the Go heap, RoosterOS's
+// vendored runtime fork (build/goroot-baremetal, outside this repo)
+// carries a runtime/mem_baremetal.go under its own runtime_baremetal
+// tag (a separate module/GOROOT build, so it doesn't need to share a
+// tag name with this package) whose sysAlloc/sysFree/sysReserve/
+// sysMap are one-line forwarders into the exported functions here.
+package memshim
+
+import "unsafe"
+
+// Region is a physical address range available for the heap.
+type Region struct {
+    Start uintptr
+    End   uintptr
+}
+
+var (
+    regions []Region
+    cursor  int     // index into regions of the region we're bumping through
+    next    uintptr // next free address within regions[cursor]
+    used    uint64  // bytes handed out so far, for MemStats-equivalent reporting
+)
+
+// Init records the physical RAM regions the allocator may hand out.
+// Call it once, before any Go allocation happens.
+func Init(discovered []Region) {
+    regions = discovered
+    cursor = 0
+    if len(regions) > 0 {
+        next = regions[0].Start
+    }
+}
+
+// Used reports how many bytes have been handed out so far.
+func Used() uint64 { return used }
+
+// SysAlloc hands out n freshly-zeroed bytes, or nil if the discovered
+// regions are exhausted. It matches the shape of runtime.sysAlloc.
+func SysAlloc(n uintptr) unsafe.Pointer {
+    n = roundUp(n, unsafe.Sizeof(uintptr(0)))
+    for cursor < len(regions) {
+        r := regions[cursor]
+        if next+n <= r.End {
+            p := next
+            next += n
+            used += uint64(n)
+            mem := unsafe.Slice((*byte)(unsafe.Pointer(p)), n)
+            for i := range mem {
+                mem[i] = 0
+            }
+            return unsafe.Pointer(p)
+        }
+        cursor++
+        if cursor < len(regions) {
+            next = regions[cursor].Start
+        }
+    }
+    return nil
+}
+
+// SysFree is a no-op: the bump allocator never reclaims memory, which
+// matches how the Go runtime treats sysFree on most platforms (it's
+// only called on the OOM/partial-mapping failure path).
+func SysFree(v unsafe.Pointer, n uintptr) {
+    if uint64(n) <= used {
+        used -= uint64(n)
+    }
+}
+
+// SysReserve reserves address space without committing physical
+// memory. On bare metal there's no separate virtual/physical mapping
+// step, so reserving is the same as allocating.
+func SysReserve(n uintptr) unsafe.Pointer {
+    return SysAlloc(n)
+}
+
+// SysMap commits address space previously returned by SysReserve. It's
+// a no-op here for the same reason SysReserve equals SysAlloc.
+func SysMap(v unsafe.Pointer, n uintptr) {}
+
+func roundUp(n, align uintptr) uintptr {
+    return (n + align - 1) &^ (align - 1)
+}
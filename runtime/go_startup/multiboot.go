@@ -0,0 +1,77 @@
+//go:build baremetal
+
+package main
+
+import "unsafe"
+
+// Multiboot2 info header and the tag we care about (MMAP, type 6). See
+// the Multiboot2 Specification §3.6 "Boot information format".
+const (
+    mbTagEnd  = 0
+    mbTagMMAP = 6
+)
+
+type mbTagHeader struct {
+    Type uint32
+    Size uint32
+}
+
+type mbMMAPTag struct {
+    mbTagHeader
+    EntrySize    uint32
+    EntryVersion uint32
+    // followed by EntrySize-sized mbMMAPEntry records
+}
+
+type mbMMAPEntry struct {
+    Addr     uint64
+    Len      uint64
+    Type     uint32 // 1 = available RAM
+    Reserved uint32
+}
+
+const mbMMAPTypeAvailable = 1
+
+// memRegion is a physical RAM range the heap shim may hand out.
+type memRegion struct {
+    Start uintptr
+    End   uintptr
+}
+
+// parseMultibootMMAP walks the Multiboot2 tag list starting at mbi and
+// returns every region marked "available" in the MMAP tag. mbi points
+// at {uint32 TotalSize; uint32 Reserved} followed by a tag list, each
+// tag 8-byte aligned.
+func parseMultibootMMAP(mbi uintptr) []memRegion {
+    if mbi == 0 {
+        return nil
+    }
+    totalSize := *(*uint32)(unsafe.Pointer(mbi))
+    end := mbi + uintptr(totalSize)
+
+    tag := mbi + 8 // skip the fixed header
+    var regions []memRegion
+    for tag < end {
+        hdr := (*mbTagHeader)(unsafe.Pointer(tag))
+        if hdr.Type == mbTagEnd {
+            break
+        }
+        if hdr.Type == mbTagMMAP {
+            mmap := (*mbMMAPTag)(unsafe.Pointer(tag))
+            entries := int((mmap.Size - 16) / mmap.EntrySize)
+            base := tag + 16
+            for i := 0; i < entries; i++ {
+                e := (*mbMMAPEntry)(unsafe.Pointer(base + uintptr(i)*uintptr(mmap.EntrySize)))
+                if e.Type == mbMMAPTypeAvailable && e.Len > 0 {
+                    regions = append(regions, memRegion{
+                        Start: uintptr(e.Addr),
+                        End:   uintptr(e.Addr + e.Len),
+                    })
+                }
+            }
+        }
+        // tags are 8-byte aligned
+        tag += uintptr((hdr.Size + 7) &^ 7)
+    }
+    return regions
+}
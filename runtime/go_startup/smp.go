@@ -0,0 +1,131 @@
+//go:build baremetal
+
+package main
+
+import "unsafe"
+
+// ACPI MADT ("Multiple APIC Description Table") — ACPI Spec §5.2.12.
+// rsdpAddr and madtAddr are resolved by the assembly boot stub before
+// runtime_start runs and stored in these linker-provided symbols,
+// mirroring how bssStart/bssEnd are supplied.
+var madtAddr uintptr
+
+type acpiTableHeader struct {
+    Signature       [4]byte
+    Length          uint32
+    Revision        uint8
+    Checksum        uint8
+    OEMID           [6]byte
+    OEMTableID      [8]byte
+    OEMRevision     uint32
+    CreatorID       uint32
+    CreatorRevision uint32
+}
+
+type madtEntryHeader struct {
+    Type   uint8
+    Length uint8
+}
+
+const (
+    madtTypeLocalAPIC = 0
+    madtLocalAPICEnabled = 1
+)
+
+type madtLocalAPIC struct {
+    madtEntryHeader
+    ProcessorID uint8
+    APICID      uint8
+    Flags       uint32
+}
+
+// localAPICBase is the memory-mapped Local APIC register window; on
+// x86 it's read from the IA32_APIC_BASE MSR by the boot stub and
+// passed here the same way madtAddr is.
+const localAPICBase = 0xFEE00000
+
+const (
+    apicRegICRLow  = 0x300
+    apicRegICRHigh = 0x310
+
+    icrDeliverInit = 0x500
+    icrDeliverSIPI = 0x600
+    icrLevelAssert = 0x4000
+)
+
+// enumerateAPICIDs walks the MADT and returns every enabled Local APIC
+// ID, which is how many logical CPUs the machine actually has.
+func enumerateAPICIDs() []uint8 {
+    if madtAddr == 0 {
+        return nil
+    }
+    hdr := (*acpiTableHeader)(unsafe.Pointer(madtAddr))
+    end := madtAddr + uintptr(hdr.Length)
+    // MADT-specific fields (local APIC address + flags) sit right
+    // after the common ACPI header, before the entry list.
+    entry := madtAddr + unsafe.Sizeof(*hdr) + 8
+
+    var ids []uint8
+    for entry < end {
+        e := (*madtEntryHeader)(unsafe.Pointer(entry))
+        if e.Length == 0 {
+            break
+        }
+        if e.Type == madtTypeLocalAPIC {
+            lapic := (*madtLocalAPIC)(unsafe.Pointer(entry))
+            if lapic.Flags&madtLocalAPICEnabled != 0 {
+                ids = append(ids, lapic.APICID)
+            }
+        }
+        entry += uintptr(e.Length)
+    }
+    return ids
+}
+
+// sendIPI writes an Interprocessor Interrupt command to the Local APIC
+// Interrupt Command Register, targeting apicID with the given vector
+// bits (an INIT or Startup IPI).
+//go:nosplit
+func sendIPI(apicID uint8, cmd uint32) {
+    icrHigh := (*uint32)(unsafe.Pointer(uintptr(localAPICBase + apicRegICRHigh)))
+    icrLow := (*uint32)(unsafe.Pointer(uintptr(localAPICBase + apicRegICRLow)))
+    *icrHigh = uint32(apicID) << 24
+    *icrLow = cmd | icrLevelAssert
+    for *icrLow&(1<<12) != 0 {
+        // wait for delivery status to clear
+    }
+}
+
+// bringUpSMP enumerates APs via the ACPI MADT and sends each the
+// standard INIT-SIPI-SIPI sequence (Intel SDM Vol. 3A §9.4.4) so they
+// jump to the AP trampoline and join the scheduler. It returns the
+// total logical CPU count (including the boot processor) for
+// runtime.GOMAXPROCS.
+func bringUpSMP() int {
+    ids := enumerateAPICIDs()
+    if len(ids) == 0 {
+        return 1 // no MADT available; assume uniprocessor
+    }
+    for _, id := range ids {
+        if id == bootAPICID() {
+            continue
+        }
+        sendIPI(id, icrDeliverInit)
+        sendIPI(id, icrDeliverSIPI|apTrampolinePage)
+        sendIPI(id, icrDeliverSIPI|apTrampolinePage)
+    }
+    return len(ids)
+}
+
+// apTrampolinePage is the real-mode page (>>12) the AP trampoline is
+// linked at; see go.linker.ld.
+const apTrampolinePage = 0x08
+
+// bootAPICID reads the boot processor's own APIC ID so bringUpSMP
+// doesn't try to IPI itself.
+//go:nosplit
+func bootAPICID() uint8 {
+    const apicRegID = 0x20
+    idReg := (*uint32)(unsafe.Pointer(uintptr(localAPICBase + apicRegID)))
+    return uint8(*idReg >> 24)
+}
@@ -0,0 +1,38 @@
+package report
+
+import (
+    "fmt"
+    "io"
+    "sort"
+    "strings"
+)
+
+// influxReporter renders a Snapshot as InfluxDB line protocol, using
+// "roosteros" as the measurement and each metric name as a field.
+type influxReporter struct{}
+
+func (influxReporter) Report(w io.Writer, s Snapshot) error {
+    for _, m := range collectMetrics(s) {
+        tags := influxTags(m.labels)
+        if _, err := fmt.Fprintf(w, "roosteros%s %s=%g\n", tags, m.name, m.value); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+func influxTags(labels map[string]string) string {
+    if len(labels) == 0 {
+        return ""
+    }
+    keys := make([]string, 0, len(labels))
+    for k := range labels {
+        keys = append(keys, k)
+    }
+    sort.Strings(keys)
+    parts := make([]string, 0, len(keys))
+    for _, k := range keys {
+        parts = append(parts, fmt.Sprintf("%s=%s", k, strings.ReplaceAll(labels[k], " ", "\\ ")))
+    }
+    return "," + strings.Join(parts, ",")
+}
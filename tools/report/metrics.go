@@ -0,0 +1,83 @@
+package report
+
+import (
+    "reflect"
+    "strings"
+    "time"
+)
+
+// metric is one flattened numeric measurement pulled from a Snapshot,
+// named after the "metric" struct tag on the source field.
+type metric struct {
+    name   string
+    value  float64
+    labels map[string]string
+}
+
+// collectMetrics walks the Snapshot reflectively, emitting one metric
+// per field tagged `metric:"..."`. Disk entries get a "mount" label so
+// each one is distinguishable.
+func collectMetrics(s Snapshot) []metric {
+    var out []metric
+    out = appendTaggedMetrics(out, reflect.ValueOf(s.CPU), nil)
+    out = appendTaggedMetrics(out, reflect.ValueOf(s.Mem), nil)
+    out = appendTaggedMetrics(out, reflect.ValueOf(s.Uptime), nil)
+    for _, d := range s.Disks {
+        out = appendTaggedMetrics(out, reflect.ValueOf(d), map[string]string{"mount": d.MountPoint})
+    }
+    return out
+}
+
+func appendTaggedMetrics(out []metric, v reflect.Value, labels map[string]string) []metric {
+    t := v.Type()
+    for i := 0; i < t.NumField(); i++ {
+        tag := t.Field(i).Tag.Get("metric")
+        if tag == "" {
+            continue
+        }
+        name, extra := splitMetricTag(tag)
+        merged := labels
+        if extra != nil {
+            merged = map[string]string{}
+            for k, v := range labels {
+                merged[k] = v
+            }
+            for k, v := range extra {
+                merged[k] = v
+            }
+        }
+        out = append(out, metric{name: name, value: toFloat(v.Field(i)), labels: merged})
+    }
+    return out
+}
+
+// splitMetricTag parses a `name` or `name{label="value"}` metric tag.
+func splitMetricTag(tag string) (name string, labels map[string]string) {
+    open := strings.IndexByte(tag, '{')
+    if open < 0 {
+        return tag, nil
+    }
+    name = tag[:open]
+    body := strings.Trim(tag[open+1:len(tag)-1], "")
+    kv := strings.SplitN(body, "=", 2)
+    if len(kv) != 2 {
+        return name, nil
+    }
+    return name, map[string]string{kv[0]: strings.Trim(kv[1], `"`)}
+}
+
+func toFloat(v reflect.Value) float64 {
+    if d, ok := v.Interface().(time.Duration); ok {
+        return d.Seconds()
+    }
+    switch v.Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return float64(v.Int())
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        return float64(v.Uint())
+    case reflect.Float32, reflect.Float64:
+        return v.Float()
+    default:
+        return 0
+    }
+}
@@ -0,0 +1,36 @@
+package report
+
+import (
+    "fmt"
+    "io"
+    "sort"
+    "strings"
+)
+
+// promReporter renders a Snapshot as Prometheus text exposition format.
+type promReporter struct{}
+
+func (promReporter) Report(w io.Writer, s Snapshot) error {
+    for _, m := range collectMetrics(s) {
+        if _, err := fmt.Fprintf(w, "%s%s %g\n", m.name, promLabels(m.labels), m.value); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+func promLabels(labels map[string]string) string {
+    if len(labels) == 0 {
+        return ""
+    }
+    keys := make([]string, 0, len(labels))
+    for k := range labels {
+        keys = append(keys, k)
+    }
+    sort.Strings(keys)
+    parts := make([]string, 0, len(keys))
+    for _, k := range keys {
+        parts = append(parts, fmt.Sprintf("%s=%q", k, labels[k]))
+    }
+    return "{" + strings.Join(parts, ",") + "}"
+}
@@ -0,0 +1,45 @@
+// Package report renders a sysinfo Snapshot in one of several
+// machine-readable formats (table, json, yaml, prom, influx).
+package report
+
+import (
+    "fmt"
+    "io"
+
+    "github.com/DrAhmadRouhimaleh/RoosterOS/tools/sysinfo"
+)
+
+// Snapshot bundles a full sysinfo collection so a Reporter can render
+// it in one pass instead of taking each field separately.
+type Snapshot struct {
+    OS     sysinfo.OSInfo
+    CPU    sysinfo.CPUInfo
+    Mem    sysinfo.MemInfo
+    Disks  []sysinfo.DiskInfo
+    Nets   []sysinfo.NetInfo
+    Uptime sysinfo.UptimeLoad
+}
+
+// Reporter renders a Snapshot to w in a specific format.
+type Reporter interface {
+    Report(w io.Writer, s Snapshot) error
+}
+
+// New returns the Reporter for the named format: "table", "json",
+// "yaml", "prom", or "influx".
+func New(format string) (Reporter, error) {
+    switch format {
+    case "", "table":
+        return tableReporter{}, nil
+    case "json":
+        return jsonReporter{}, nil
+    case "yaml":
+        return yamlReporter{}, nil
+    case "prom":
+        return promReporter{}, nil
+    case "influx":
+        return influxReporter{}, nil
+    default:
+        return nil, fmt.Errorf("report: unknown format %q", format)
+    }
+}
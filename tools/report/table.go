@@ -0,0 +1,68 @@
+package report
+
+import (
+    "fmt"
+    "io"
+    "strings"
+    "text/tabwriter"
+    "time"
+)
+
+// tableReporter is the original human-readable layout the command
+// printed before -format existed; it remains the default.
+type tableReporter struct{}
+
+func (tableReporter) Report(w io.Writer, s Snapshot) error {
+    tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+    fmt.Fprintln(tw, "== OS ==")
+    fmt.Fprintf(tw, "Distro:\t%s %s\n", s.OS.Name, s.OS.Version)
+    fmt.Fprintf(tw, "Kernel:\t%s\n\n", s.OS.Kernel)
+
+    fmt.Fprintln(tw, "== CPU ==")
+    fmt.Fprintf(tw, "Vendor:\t%s\n", s.CPU.VendorID)
+    fmt.Fprintf(tw, "Model:\t%s\n", s.CPU.ModelName)
+    fmt.Fprintf(tw, "Family:\t%s Model:\t%s Stepping:\t%s\n", s.CPU.Family, s.CPU.Model, s.CPU.Stepping)
+    fmt.Fprintf(tw, "Cores (phys/logical):\t%d/%d\n", s.CPU.Cores, s.CPU.Threads)
+    fmt.Fprintf(tw, "Effective cores:\t%d\n", s.CPU.EffectiveCores)
+    fmt.Fprintf(tw, "Frequency (MHz):\t%.2f\n", s.CPU.MHz)
+    fmt.Fprintf(tw, "Flags:\t%s\n\n", strings.Join(s.CPU.Flags, " "))
+
+    fmt.Fprintln(tw, "== Memory (MB) ==")
+    fmt.Fprintf(tw, "Total:\t%d\tFree:\t%d\tAvailable:\t%d\n",
+        s.Mem.Total/1024/1024, s.Mem.Free/1024/1024, s.Mem.Available/1024/1024)
+    fmt.Fprintf(tw, "Buffers:\t%d\tCached:\t%d\n",
+        s.Mem.Buffers/1024/1024, s.Mem.Cached/1024/1024)
+    fmt.Fprintf(tw, "Swap Total:\t%d\tSwap Free:\t%d\n",
+        s.Mem.SwapTotal/1024/1024, s.Mem.SwapFree/1024/1024)
+    fmt.Fprintf(tw, "Effective limit:\t%d\n\n", s.Mem.EffectiveLimit/1024/1024)
+
+    fmt.Fprintln(tw, "== Disks ==")
+    fmt.Fprintf(tw, "Mount\tType\tTotal(GB)\tFree(GB)\tAvail(GB)\tModel\tTemp(C)\tPowerOnHrs\tUsed%%\tMediaErrs\tSMART\n")
+    for _, d := range s.Disks {
+        smart := "n/a"
+        if d.SmartOK {
+            smart = "ok"
+        }
+        fmt.Fprintf(tw, "%s\t%s\t%.2f\t%.2f\t%.2f\t%s\t%d\t%d\t%d\t%d\t%s\n",
+            d.MountPoint, d.FsType,
+            float64(d.Total)/1e9, float64(d.Free)/1e9, float64(d.Available)/1e9,
+            d.Model, d.TempC, d.PowerOnHours, d.PercentUsed, d.MediaErrors, smart)
+    }
+    fmt.Fprintln(tw)
+
+    fmt.Fprintln(tw, "== Network Interfaces ==")
+    fmt.Fprintf(tw, "Name\tHWAddr\tAddresses\n")
+    for _, ni := range s.Nets {
+        fmt.Fprintf(tw, "%s\t%s\t%s\n",
+            ni.Name, ni.HardwareAddr, strings.Join(ni.Addrs, ","))
+    }
+    fmt.Fprintln(tw)
+
+    fmt.Fprintln(tw, "== Uptime & Load ==")
+    fmt.Fprintf(tw, "Uptime:\t%s\n", s.Uptime.Uptime.Truncate(time.Second))
+    fmt.Fprintf(tw, "Load Avg (1/5/15):\t%.2f\t%.2f\t%.2f\n",
+        s.Uptime.Load1, s.Uptime.Load5, s.Uptime.Load15)
+
+    return tw.Flush()
+}
@@ -0,0 +1,15 @@
+package report
+
+import (
+    "io"
+
+    "gopkg.in/yaml.v3"
+)
+
+type yamlReporter struct{}
+
+func (yamlReporter) Report(w io.Writer, s Snapshot) error {
+    enc := yaml.NewEncoder(w)
+    defer enc.Close()
+    return enc.Encode(s)
+}
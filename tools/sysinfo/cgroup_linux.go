@@ -0,0 +1,151 @@
+//go:build linux
+
+package sysinfo
+
+import (
+    "bufio"
+    "math"
+    "os"
+    "path/filepath"
+    "strconv"
+    "strings"
+)
+
+// cgroupPath resolves the controller mount this process is confined to,
+// preferring the unified (v2) hierarchy and falling back to the named
+// v1 "cpu" or "memory" controllers.
+func cgroupPath(controller string) (string, bool) {
+    file, err := os.Open("/proc/self/cgroup")
+    if err != nil {
+        return "", false
+    }
+    defer file.Close()
+
+    var v1Path, v2Path string
+    scanner := bufio.NewScanner(file)
+    for scanner.Scan() {
+        // format: hierarchy-ID:controller-list:path
+        f := strings.SplitN(scanner.Text(), ":", 3)
+        if len(f) != 3 {
+            continue
+        }
+        switch {
+        case f[1] == "" && v2Path == "":
+            v2Path = f[2]
+        case v1Path == "" && controllerListHas(f[1], controller):
+            v1Path = f[2]
+        }
+    }
+
+    if v2Path != "" {
+        if p := filepath.Join("/sys/fs/cgroup", v2Path); fileExists(p) {
+            return p, true
+        }
+    }
+    if v1Path != "" {
+        return filepath.Join("/sys/fs/cgroup", controller, v1Path), true
+    }
+    return "", false
+}
+
+func controllerListHas(list, want string) bool {
+    for _, c := range strings.Split(list, ",") {
+        if c == want {
+            return true
+        }
+    }
+    return false
+}
+
+func fileExists(path string) bool {
+    _, err := os.Stat(path)
+    return err == nil
+}
+
+func readUintFile(path string) (uint64, bool) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return 0, false
+    }
+    v, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+    if err != nil {
+        return 0, false
+    }
+    return v, true
+}
+
+// readIntFile is readUintFile's signed counterpart, for v1 cgroup
+// files like cpu.cfs_quota_us that use -1 as an "unconstrained"
+// sentinel rather than a plain unsigned count.
+func readIntFile(path string) (int64, bool) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return 0, false
+    }
+    v, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+    if err != nil {
+        return 0, false
+    }
+    return v, true
+}
+
+// cgroupCPUQuota returns the effective core count derived from cpu.max
+// (v2) or cpu.cfs_quota_us/cpu.cfs_period_us (v1), rounded up. ok is
+// false when no quota is in effect (the process sees the host's CPUs).
+func cgroupCPUQuota() (cores int, ok bool) {
+    dir, found := cgroupPath("cpu")
+    if !found {
+        return 0, false
+    }
+
+    if data, err := os.ReadFile(filepath.Join(dir, "cpu.max")); err == nil {
+        f := strings.Fields(string(data))
+        if len(f) == 2 && f[0] != "max" {
+            quota, err1 := strconv.ParseFloat(f[0], 64)
+            period, err2 := strconv.ParseFloat(f[1], 64)
+            if err1 == nil && err2 == nil && period > 0 {
+                return int(math.Ceil(quota / period)), true
+            }
+        }
+        return 0, false
+    }
+
+    quota, hasQuota := readIntFile(filepath.Join(dir, "cpu.cfs_quota_us"))
+    period, hasPeriod := readUintFile(filepath.Join(dir, "cpu.cfs_period_us"))
+    if hasQuota && hasPeriod && period > 0 {
+        if quota <= 0 { // -1 means "unconstrained" on v1
+            return 0, false
+        }
+        return int(math.Ceil(float64(quota) / float64(period))), true
+    }
+    return 0, false
+}
+
+// cgroupMemLimit returns the effective memory limit in bytes from
+// memory.max (v2) or memory.limit_in_bytes (v1). ok is false when no
+// limit is in effect.
+func cgroupMemLimit() (limit uint64, ok bool) {
+    dir, found := cgroupPath("memory")
+    if !found {
+        return 0, false
+    }
+
+    if data, err := os.ReadFile(filepath.Join(dir, "memory.max")); err == nil {
+        s := strings.TrimSpace(string(data))
+        if s == "max" {
+            return 0, false
+        }
+        v, err := strconv.ParseUint(s, 10, 64)
+        return v, err == nil
+    }
+
+    v, found := readUintFile(filepath.Join(dir, "memory.limit_in_bytes"))
+    if !found {
+        return 0, false
+    }
+    // v1 reports ~max-uint64 ("no limit") as a huge sentinel value.
+    if v > 1<<62 {
+        return 0, false
+    }
+    return v, true
+}
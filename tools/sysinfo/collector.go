@@ -0,0 +1,147 @@
+// Package sysinfo gathers host information across platforms.
+//
+// Each supported OS ships its own build-tagged implementation of the
+// Collector interface (sysinfo_linux.go, sysinfo_darwin.go,
+// sysinfo_freebsd.go, sysinfo_windows.go). Callers should depend only on
+// this interface so that callers such as the system_info command or
+// profile.Profile validation work unmodified on every platform.
+package sysinfo
+
+import (
+    "encoding/json"
+    "time"
+)
+
+// OSInfo holds distribution and kernel info.
+type OSInfo struct {
+    Name    string `json:"name" yaml:"name"`
+    Version string `json:"version" yaml:"version"`
+    Kernel  string `json:"kernel" yaml:"kernel"`
+}
+
+// CPUInfo holds processor details.
+type CPUInfo struct {
+    VendorID  string   `json:"vendor_id" yaml:"vendor_id"`
+    ModelName string   `json:"model_name" yaml:"model_name"`
+    Family    string   `json:"family" yaml:"family"`
+    Model     string   `json:"model" yaml:"model"`
+    Stepping  string   `json:"stepping" yaml:"stepping"`
+    Cores     int      `json:"cores" yaml:"cores" metric:"roosteros_cpu_cores"` // physical
+    Threads   int      `json:"threads" yaml:"threads" metric:"roosteros_cpu_threads"` // logical
+    Flags     []string `json:"flags" yaml:"flags"`
+    MHz       float64  `json:"mhz" yaml:"mhz" metric:"roosteros_cpu_mhz"`
+
+    // EffectiveCores is the CPU quota visible to this process (e.g. a
+    // cgroup limit), rounded up to a whole core count. It equals
+    // Threads when no quota is in effect.
+    EffectiveCores int `json:"effective_cores" yaml:"effective_cores" metric:"roosteros_cpu_effective_cores"`
+}
+
+// MemInfo holds memory statistics in bytes.
+type MemInfo struct {
+    Total     uint64 `json:"total_bytes" yaml:"total_bytes" metric:"roosteros_mem_total_bytes"`
+    Free      uint64 `json:"free_bytes" yaml:"free_bytes" metric:"roosteros_mem_free_bytes"`
+    Available uint64 `json:"available_bytes" yaml:"available_bytes" metric:"roosteros_mem_available_bytes"`
+    Buffers   uint64 `json:"buffers_bytes" yaml:"buffers_bytes" metric:"roosteros_mem_buffers_bytes"`
+    Cached    uint64 `json:"cached_bytes" yaml:"cached_bytes" metric:"roosteros_mem_cached_bytes"`
+    SwapTotal uint64 `json:"swap_total_bytes" yaml:"swap_total_bytes" metric:"roosteros_mem_swap_total_bytes"`
+    SwapFree  uint64 `json:"swap_free_bytes" yaml:"swap_free_bytes" metric:"roosteros_mem_swap_free_bytes"`
+
+    // EffectiveLimit is the memory limit visible to this process (e.g.
+    // a cgroup limit), in bytes. It equals Total when no limit is set.
+    EffectiveLimit uint64 `json:"effective_limit_bytes" yaml:"effective_limit_bytes" metric:"roosteros_mem_effective_limit_bytes"`
+}
+
+// DiskInfo holds filesystem usage and, where available, block-device
+// health pulled via SMART (SATA) or the NVMe admin command set.
+type DiskInfo struct {
+    MountPoint string `json:"mount_point" yaml:"mount_point"`
+    FsType     string `json:"fs_type" yaml:"fs_type"`
+    Total      uint64 `json:"total_bytes" yaml:"total_bytes" metric:"roosteros_disk_total_bytes"`
+    Free       uint64 `json:"free_bytes" yaml:"free_bytes" metric:"roosteros_disk_free_bytes"`
+    Available  uint64 `json:"available_bytes" yaml:"available_bytes" metric:"roosteros_disk_available_bytes"`
+
+    // Health fields. SmartOK is false (and the rest zero-valued) when
+    // the underlying device couldn't be resolved, doesn't support
+    // SMART/NVMe health reporting, or the process lacks CAP_SYS_RAWIO.
+    Model        string  `json:"model,omitempty" yaml:"model,omitempty"`
+    Serial       string  `json:"serial,omitempty" yaml:"serial,omitempty"`
+    TempC        int     `json:"temp_c,omitempty" yaml:"temp_c,omitempty" metric:"roosteros_disk_temp_celsius"`
+    PowerOnHours uint64  `json:"power_on_hours,omitempty" yaml:"power_on_hours,omitempty" metric:"roosteros_disk_power_on_hours"`
+    PercentUsed  int     `json:"percent_used,omitempty" yaml:"percent_used,omitempty" metric:"roosteros_disk_percent_used"`
+    MediaErrors  uint64  `json:"media_errors,omitempty" yaml:"media_errors,omitempty" metric:"roosteros_disk_media_errors"`
+    SmartOK      bool    `json:"smart_ok" yaml:"smart_ok"`
+}
+
+// NetInfo holds network interface details.
+type NetInfo struct {
+    Name         string   `json:"name" yaml:"name"`
+    HardwareAddr string   `json:"hardware_addr" yaml:"hardware_addr"`
+    Addrs        []string `json:"addrs" yaml:"addrs"`
+}
+
+// UptimeLoad holds uptime and load averages.
+type UptimeLoad struct {
+    Uptime time.Duration `json:"uptime_seconds" yaml:"uptime_seconds" metric:"roosteros_uptime_seconds"`
+    Load1  float64       `json:"load1" yaml:"load1" metric:"roosteros_load_average{window=\"1\"}"`
+    Load5  float64       `json:"load5" yaml:"load5" metric:"roosteros_load_average{window=\"5\"}"`
+    Load15 float64       `json:"load15" yaml:"load15" metric:"roosteros_load_average{window=\"15\"}"`
+}
+
+// uptimeLoadWire is UptimeLoad's on-the-wire shape: encoding/json and
+// yaml.v3 both serialize time.Duration as its raw int64 nanoseconds,
+// which would make a field literally named uptime_seconds report
+// nanoseconds. Route both formats through this instead.
+type uptimeLoadWire struct {
+    UptimeSeconds float64 `json:"uptime_seconds" yaml:"uptime_seconds"`
+    Load1         float64 `json:"load1" yaml:"load1"`
+    Load5         float64 `json:"load5" yaml:"load5"`
+    Load15        float64 `json:"load15" yaml:"load15"`
+}
+
+func (u UptimeLoad) wire() uptimeLoadWire {
+    return uptimeLoadWire{
+        UptimeSeconds: u.Uptime.Seconds(),
+        Load1:         u.Load1,
+        Load5:         u.Load5,
+        Load15:        u.Load15,
+    }
+}
+
+// MarshalJSON reports Uptime in seconds rather than json.Marshal's
+// default of time.Duration's raw nanoseconds.
+func (u UptimeLoad) MarshalJSON() ([]byte, error) {
+    return json.Marshal(u.wire())
+}
+
+// MarshalYAML reports Uptime in seconds for the same reason as
+// MarshalJSON.
+func (u UptimeLoad) MarshalYAML() (interface{}, error) {
+    return u.wire(), nil
+}
+
+// ProcessInfo holds a single running process.
+type ProcessInfo struct {
+    PID     int
+    Name    string
+    User    string
+    RSS     uint64 // bytes
+    CPUPct  float64
+}
+
+// Collector gathers host information. Implementations are platform
+// specific; use New to get the one for the running OS.
+type Collector interface {
+    OS() (OSInfo, error)
+    CPU() (CPUInfo, error)
+    Mem() (MemInfo, error)
+    Disks() ([]DiskInfo, error)
+    Net() ([]NetInfo, error)
+    UptimeLoad() (UptimeLoad, error)
+    Processes() ([]ProcessInfo, error)
+}
+
+// New returns the Collector for the platform this binary was built for.
+func New() Collector {
+    return newCollector()
+}
@@ -0,0 +1,223 @@
+//go:build linux
+
+package sysinfo
+
+import (
+    "bufio"
+    "os"
+    "path/filepath"
+    "strings"
+    "unsafe"
+
+    "golang.org/x/sys/unix"
+)
+
+// nvmeIoctlAdminCmd is NVME_IOCTL_ADMIN_CMD, _IOWR('N', 0x41, struct
+// nvme_admin_cmd), from <linux/nvme_ioctl.h>.
+const nvmeIoctlAdminCmd = 0xC0484E41
+
+// nvmeAdminCmd mirrors struct nvme_admin_cmd from <linux/nvme_ioctl.h>.
+type nvmeAdminCmd struct {
+    Opcode      uint8
+    Flags       uint8
+    Rsvd1       uint16
+    Nsid        uint32
+    Cdw2        uint32
+    Cdw3        uint32
+    Metadata    uint64
+    Addr        uint64
+    MetadataLen uint32
+    DataLen     uint32
+    Cdw10       uint32
+    Cdw11       uint32
+    Cdw12       uint32
+    Cdw13       uint32
+    Cdw14       uint32
+    Cdw15       uint32
+    TimeoutMS   uint32
+    Result      uint32
+}
+
+// resolveBlockDevice maps a mountpoint to its underlying block device
+// (e.g. /dev/sda) by reading /proc/mounts and then stripping the
+// partition suffix found under /sys/block.
+func resolveBlockDevice(mountpoint string) (string, bool) {
+    file, err := os.Open("/proc/mounts")
+    if err != nil {
+        return "", false
+    }
+    defer file.Close()
+
+    var devPath string
+    scanner := bufio.NewScanner(file)
+    for scanner.Scan() {
+        f := strings.Fields(scanner.Text())
+        if len(f) < 2 || f[1] != mountpoint {
+            continue
+        }
+        devPath = f[0]
+        break
+    }
+    if !strings.HasPrefix(devPath, "/dev/") {
+        return "", false
+    }
+
+    name := strings.TrimPrefix(devPath, "/dev/")
+    entries, err := os.ReadDir("/sys/block")
+    if err != nil {
+        return "", false
+    }
+    for _, e := range entries {
+        if name == e.Name() || strings.HasPrefix(name, e.Name()) {
+            return filepath.Join("/dev", e.Name()), true
+        }
+    }
+    return "", false
+}
+
+// fillSmartHealth resolves d's backing device and, if it supports
+// SMART (SATA) or the NVMe health log, fills in the health fields. It
+// never returns an error: missing devices, missing CAP_SYS_RAWIO, or
+// drives without SMART support just leave SmartOK false.
+func fillSmartHealth(d *DiskInfo) {
+    dev, ok := resolveBlockDevice(d.MountPoint)
+    if !ok {
+        return
+    }
+
+    fd, err := unix.Open(dev, unix.O_RDONLY|unix.O_NONBLOCK, 0)
+    if err != nil {
+        return // likely missing CAP_SYS_RAWIO
+    }
+    defer unix.Close(fd)
+
+    if strings.Contains(dev, "nvme") {
+        fillNVMeHealth(fd, d)
+    } else {
+        fillATASmart(fd, d)
+    }
+}
+
+// fillNVMeHealth issues Get Log Page (LID 0x02, SMART/Health) via
+// NVME_IOCTL_ADMIN_CMD and parses the 512-byte log returned by the
+// controller.
+func fillNVMeHealth(fd int, d *DiskInfo) {
+    const (
+        opGetLogPage = 0x02
+        lidSmartHealth = 0x02
+        logSize      = 512
+    )
+    buf := make([]byte, logSize)
+    cmd := nvmeAdminCmd{
+        Opcode:  opGetLogPage,
+        Nsid:    0xFFFFFFFF, // controller-wide log
+        Addr:    uint64(uintptr(unsafe.Pointer(&buf[0]))),
+        DataLen: logSize,
+        Cdw10:   uint32(lidSmartHealth) | (((logSize / 4) - 1) << 16),
+    }
+    if _, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), nvmeIoctlAdminCmd, uintptr(unsafe.Pointer(&cmd))); errno != 0 {
+        return
+    }
+
+    // Layout per NVMe Base Spec "SMART / Health Information" log page.
+    critWarning := buf[0]
+    d.TempC = int(uint16(buf[1])|uint16(buf[2])<<8) - 273 // Kelvin
+    d.PercentUsed = int(buf[5])
+    d.MediaErrors = leUint64(buf[160:168])
+    d.PowerOnHours = leUint64(buf[128:136])
+    d.SmartOK = critWarning == 0
+}
+
+// sgIOHdr mirrors struct sg_io_hdr from <scsi/sg.h>. x/sys/unix doesn't
+// wrap the SCSI generic ioctl, so we lay it out ourselves.
+type sgIOHdr struct {
+    InterfaceID    int32
+    DxferDirection int32
+    CmdLen         uint8
+    MxSbLen        uint8
+    IovecCount     uint16
+    DxferLen       uint32
+    Dxferp         unsafe.Pointer
+    Cmdp           *byte
+    Sbp            *byte
+    Timeout        uint32
+    Flags          uint32
+    PackID         int32
+    UsrPtr         unsafe.Pointer
+    Status         uint8
+    MaskedStatus   uint8
+    MsgStatus      uint8
+    SbLenWr        uint8
+    HostStatus     uint16
+    DriverStatus   uint16
+    Resid          int32
+    Duration       uint32
+    Info           uint32
+}
+
+const (
+    sgIOIoctl        = 0x2285 // SG_IO, _IOWR('S', 0x85, struct sg_io_hdr)
+    sgDxferFromDev   = -3    // SG_DXFER_FROM_DEV
+)
+
+// fillATASmart issues an ATA PASS-THROUGH(16) READ SMART DATA command
+// via SG_IO and parses the returned 512-byte structure.
+func fillATASmart(fd int, d *DiskInfo) {
+    const (
+        ataCmdSmart     = 0xB0 // SMART
+        ataSubRead      = 0xD0 // READ SMART DATA (feature register)
+        ataSmartLBAMid  = 0x4F // required SMART signature, LBA Mid register
+        ataSmartLBAHigh = 0xC2 // required SMART signature, LBA High register
+        sgioATA16Len    = 16
+        dataLen         = 512
+    )
+    cdb := make([]byte, sgioATA16Len)
+    cdb[0] = 0x85 // ATA PASS-THROUGH (16)
+    cdb[1] = 4 << 1
+    cdb[2] = 0x0E // PROTOCOL=PIO-IN, T_DIR=1, BYTE_BLOCK=1, T_LENGTH=2
+    cdb[4] = ataSubRead
+    cdb[10] = ataSmartLBAMid  // LBA Mid: drives require 0x4F to recognize SMART cmds
+    cdb[12] = ataSmartLBAHigh // LBA High: drives require 0xC2 to recognize SMART cmds
+    cdb[14] = ataCmdSmart
+
+    buf := make([]byte, dataLen)
+    hdr := sgIOHdr{
+        InterfaceID:    'S',
+        DxferDirection: sgDxferFromDev,
+        CmdLen:         sgioATA16Len,
+        Cmdp:           &cdb[0],
+        DxferLen:       uint32(len(buf)),
+        Dxferp:         unsafe.Pointer(&buf[0]),
+        Timeout:        2000,
+    }
+    if _, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), sgIOIoctl, uintptr(unsafe.Pointer(&hdr))); errno != 0 {
+        return
+    }
+    if hdr.Status != 0 {
+        return
+    }
+
+    // SMART attribute table: 30-byte entries starting at offset 2,
+    // attribute 9 = power-on hours, attribute 194 = temperature.
+    for off := 2; off+12 <= len(buf); off += 12 {
+        id := buf[off]
+        raw := leUint64(buf[off+5 : off+11])
+        switch id {
+        case 9:
+            d.PowerOnHours = raw
+        case 194:
+            d.TempC = int(raw & 0xFF)
+        case 5, 197, 198:
+            d.MediaErrors += raw
+        }
+    }
+    d.SmartOK = true
+}
+
+func leUint64(b []byte) uint64 {
+    var v uint64
+    for i := len(b) - 1; i >= 0; i-- {
+        v = v<<8 | uint64(b[i])
+    }
+    return v
+}
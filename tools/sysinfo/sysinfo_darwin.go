@@ -0,0 +1,192 @@
+//go:build darwin
+
+package sysinfo
+
+import (
+    "fmt"
+    "net"
+    "os/exec"
+    "strconv"
+    "strings"
+    "time"
+    "unsafe"
+
+    "golang.org/x/sys/unix"
+)
+
+type darwinCollector struct{}
+
+func newCollector() Collector { return darwinCollector{} }
+
+func sysctlString(name string) string {
+    s, err := unix.Sysctl(name)
+    if err != nil {
+        return ""
+    }
+    return s
+}
+
+func sysctlUint64(name string) uint64 {
+    v, err := unix.SysctlUint64(name)
+    if err != nil {
+        return 0
+    }
+    return v
+}
+
+// OS reads the product version and kernel release via sysctl.
+func (darwinCollector) OS() (OSInfo, error) {
+    info := OSInfo{Name: "macOS", Version: "unknown", Kernel: "unknown"}
+    if out, err := exec.Command("sw_vers", "-productVersion").Output(); err == nil {
+        info.Version = strings.TrimSpace(string(out))
+    }
+    info.Kernel = sysctlString("kern.osrelease")
+    return info, nil
+}
+
+// CPU reads processor details via hw.* and machdep.cpu.* sysctls.
+func (darwinCollector) CPU() (CPUInfo, error) {
+    info := CPUInfo{}
+    info.ModelName = sysctlString("machdep.cpu.brand_string")
+    if n, err := unix.SysctlUint32("hw.physicalcpu"); err == nil {
+        info.Cores = int(n)
+    }
+    if n, err := unix.SysctlUint32("hw.logicalcpu"); err == nil {
+        info.Threads = int(n)
+    }
+    if hz := sysctlUint64("hw.cpufrequency"); hz > 0 {
+        info.MHz = float64(hz) / 1e6
+    }
+    // macOS has no cgroup-style CPU quota; the effective view is the host view.
+    info.EffectiveCores = info.Threads
+    return info, nil
+}
+
+// Mem reads hw.memsize and vm.swapusage via sysctl.
+func (darwinCollector) Mem() (MemInfo, error) {
+    m := MemInfo{}
+    m.Total = sysctlUint64("hw.memsize")
+    if raw, err := unix.SysctlRaw("vm.swapusage"); err == nil && len(raw) >= 24 {
+        m.SwapTotal = *(*uint64)(unsafe.Pointer(&raw[0]))
+        m.SwapFree = *(*uint64)(unsafe.Pointer(&raw[16]))
+    }
+    // macOS has no cgroup-style memory limit; the effective view is the host view.
+    m.EffectiveLimit = m.Total
+    return m, nil
+}
+
+// Disks statfs's every BSD-visible mount point.
+func (darwinCollector) Disks() ([]DiskInfo, error) {
+    var res []DiskInfo
+    n, err := unix.Getfsstat(nil, unix.MNT_NOWAIT)
+    if err != nil || n <= 0 {
+        return res, nil
+    }
+    bufs := make([]unix.Statfs_t, n)
+    if _, err := unix.Getfsstat(bufs, unix.MNT_NOWAIT); err != nil {
+        return res, nil
+    }
+    for _, st := range bufs {
+        fs := unix.ByteSliceToString(st.Fstypename[:])
+        if fs == "devfs" {
+            continue
+        }
+        res = append(res, DiskInfo{
+            MountPoint: unix.ByteSliceToString(st.Mntonname[:]),
+            FsType:     fs,
+            Total:      st.Blocks * uint64(st.Bsize),
+            Free:       st.Bfree * uint64(st.Bsize),
+            Available:  uint64(st.Bavail) * uint64(st.Bsize),
+        })
+    }
+    return res, nil
+}
+
+// Net enumerates network interfaces and addresses.
+func (darwinCollector) Net() ([]NetInfo, error) {
+    var out []NetInfo
+    ifs, err := net.Interfaces()
+    if err != nil {
+        return out, nil
+    }
+    for _, iface := range ifs {
+        if (iface.Flags & net.FlagUp) == 0 {
+            continue
+        }
+        var addrs []string
+        if al, err := iface.Addrs(); err == nil {
+            for _, a := range al {
+                addrs = append(addrs, a.String())
+            }
+        }
+        out = append(out, NetInfo{
+            Name:         iface.Name,
+            HardwareAddr: iface.HardwareAddr.String(),
+            Addrs:        addrs,
+        })
+    }
+    return out, nil
+}
+
+// UptimeLoad reads kern.boottime (struct timeval, 8-byte tv_sec on
+// 64-bit Darwin) and vm.loadavg (fixed-point struct loadavg).
+func (darwinCollector) UptimeLoad() (UptimeLoad, error) {
+    ul := UptimeLoad{}
+    if raw, err := unix.SysctlRaw("kern.boottime"); err == nil && len(raw) >= 8 {
+        sec := *(*int64)(unsafe.Pointer(&raw[0]))
+        ul.Uptime = time.Since(time.Unix(sec, 0))
+    }
+    ul.Load1, ul.Load5, ul.Load15 = readLoadavg()
+    return ul, nil
+}
+
+// readLoadavg parses vm.loadavg's `struct loadavg { fixpt_t ldavg[3];
+// long fscale; }`, shared verbatim between Darwin and FreeBSD.
+func readLoadavg() (load1, load5, load15 float64) {
+    raw, err := unix.SysctlRaw("vm.loadavg")
+    if err != nil || len(raw) < 4*4+8 {
+        return 0, 0, 0
+    }
+    ldavg := [3]uint32{
+        *(*uint32)(unsafe.Pointer(&raw[0])),
+        *(*uint32)(unsafe.Pointer(&raw[4])),
+        *(*uint32)(unsafe.Pointer(&raw[8])),
+    }
+    fscale := *(*int64)(unsafe.Pointer(&raw[16]))
+    if fscale == 0 {
+        return 0, 0, 0
+    }
+    return float64(ldavg[0]) / float64(fscale),
+        float64(ldavg[1]) / float64(fscale),
+        float64(ldavg[2]) / float64(fscale)
+}
+
+// Processes shells out to `ps` for a BSD-style process table.
+func (darwinCollector) Processes() ([]ProcessInfo, error) {
+    out, err := exec.Command("ps", "-axo", "pid,user,rss,%cpu,comm").Output()
+    if err != nil {
+        return nil, fmt.Errorf("sysinfo: ps: %w", err)
+    }
+    var procs []ProcessInfo
+    lines := strings.Split(string(out), "\n")
+    for _, line := range lines[1:] {
+        f := strings.Fields(line)
+        if len(f) < 5 {
+            continue
+        }
+        pid, err := strconv.Atoi(f[0])
+        if err != nil {
+            continue
+        }
+        rssKB, _ := strconv.ParseUint(f[2], 10, 64)
+        cpu, _ := strconv.ParseFloat(f[3], 64)
+        procs = append(procs, ProcessInfo{
+            PID:    pid,
+            User:   f[1],
+            RSS:    rssKB * 1024,
+            CPUPct: cpu,
+            Name:   strings.Join(f[4:], " "),
+        })
+    }
+    return procs, nil
+}
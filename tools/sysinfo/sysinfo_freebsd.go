@@ -0,0 +1,183 @@
+//go:build freebsd
+
+package sysinfo
+
+import (
+    "fmt"
+    "net"
+    "os/exec"
+    "strconv"
+    "strings"
+    "time"
+    "unsafe"
+
+    "golang.org/x/sys/unix"
+)
+
+type freebsdCollector struct{}
+
+func newCollector() Collector { return freebsdCollector{} }
+
+func sysctlString(name string) string {
+    s, err := unix.Sysctl(name)
+    if err != nil {
+        return ""
+    }
+    return s
+}
+
+// OS reads the kernel release and version via sysctl.
+func (freebsdCollector) OS() (OSInfo, error) {
+    return OSInfo{
+        Name:    "FreeBSD",
+        Version: sysctlString("kern.osrelease"),
+        Kernel:  sysctlString("kern.version"),
+    }, nil
+}
+
+// CPU reads processor details via hw.* sysctls.
+func (freebsdCollector) CPU() (CPUInfo, error) {
+    info := CPUInfo{ModelName: sysctlString("hw.model")}
+    if n, err := unix.SysctlUint32("hw.ncpu"); err == nil {
+        info.Threads = int(n)
+        info.Cores = int(n)
+    }
+    if hz, err := unix.SysctlUint32("hw.clockrate"); err == nil {
+        info.MHz = float64(hz)
+    }
+    // FreeBSD jails aside, there's no cgroup-style CPU quota here; the
+    // effective view is the host view.
+    info.EffectiveCores = info.Threads
+    return info, nil
+}
+
+// Mem reads hw.physmem and vm.swap_total via sysctl.
+func (freebsdCollector) Mem() (MemInfo, error) {
+    m := MemInfo{}
+    if v, err := unix.SysctlUint64("hw.physmem"); err == nil {
+        m.Total = v
+    }
+    if v, err := unix.SysctlUint32("vm.stats.vm.v_free_count"); err == nil {
+        if pg, err := unix.SysctlUint32("hw.pagesize"); err == nil {
+            m.Free = uint64(v) * uint64(pg)
+        }
+    }
+    // FreeBSD jails aside, there's no cgroup-style memory limit here; the
+    // effective view is the host view.
+    m.EffectiveLimit = m.Total
+    return m, nil
+}
+
+// Disks statfs's every visible mount point via getfsstat(2).
+func (freebsdCollector) Disks() ([]DiskInfo, error) {
+    var res []DiskInfo
+    n, err := unix.Getfsstat(nil, unix.MNT_NOWAIT)
+    if err != nil || n <= 0 {
+        return res, nil
+    }
+    bufs := make([]unix.Statfs_t, n)
+    if _, err := unix.Getfsstat(bufs, unix.MNT_NOWAIT); err != nil {
+        return res, nil
+    }
+    for _, st := range bufs {
+        fs := unix.ByteSliceToString(st.Fstypename[:])
+        if fs == "devfs" {
+            continue
+        }
+        res = append(res, DiskInfo{
+            MountPoint: unix.ByteSliceToString(st.Mntonname[:]),
+            FsType:     fs,
+            Total:      uint64(st.Blocks) * uint64(st.Bsize),
+            Free:       uint64(st.Bfree) * uint64(st.Bsize),
+            Available:  uint64(st.Bavail) * uint64(st.Bsize),
+        })
+    }
+    return res, nil
+}
+
+// Net enumerates network interfaces and addresses.
+func (freebsdCollector) Net() ([]NetInfo, error) {
+    var out []NetInfo
+    ifs, err := net.Interfaces()
+    if err != nil {
+        return out, nil
+    }
+    for _, iface := range ifs {
+        if (iface.Flags & net.FlagUp) == 0 {
+            continue
+        }
+        var addrs []string
+        if al, err := iface.Addrs(); err == nil {
+            for _, a := range al {
+                addrs = append(addrs, a.String())
+            }
+        }
+        out = append(out, NetInfo{
+            Name:         iface.Name,
+            HardwareAddr: iface.HardwareAddr.String(),
+            Addrs:        addrs,
+        })
+    }
+    return out, nil
+}
+
+// UptimeLoad reads kern.boottime and vm.loadavg via sysctl.
+func (freebsdCollector) UptimeLoad() (UptimeLoad, error) {
+    ul := UptimeLoad{}
+    if tv, err := unix.SysctlTimeval("kern.boottime"); err == nil {
+        ul.Uptime = time.Since(time.Unix(tv.Sec, int64(tv.Usec)*1000))
+    }
+    ul.Load1, ul.Load5, ul.Load15 = readLoadavg()
+    return ul, nil
+}
+
+// readLoadavg parses vm.loadavg's `struct loadavg { fixpt_t ldavg[3];
+// long fscale; }`, shared verbatim between FreeBSD and Darwin.
+func readLoadavg() (load1, load5, load15 float64) {
+    raw, err := unix.SysctlRaw("vm.loadavg")
+    if err != nil || len(raw) < 4*4+8 {
+        return 0, 0, 0
+    }
+    ldavg := [3]uint32{
+        *(*uint32)(unsafe.Pointer(&raw[0])),
+        *(*uint32)(unsafe.Pointer(&raw[4])),
+        *(*uint32)(unsafe.Pointer(&raw[8])),
+    }
+    fscale := *(*int64)(unsafe.Pointer(&raw[16]))
+    if fscale == 0 {
+        return 0, 0, 0
+    }
+    return float64(ldavg[0]) / float64(fscale),
+        float64(ldavg[1]) / float64(fscale),
+        float64(ldavg[2]) / float64(fscale)
+}
+
+// Processes shells out to `ps` for a BSD-style process table.
+func (freebsdCollector) Processes() ([]ProcessInfo, error) {
+    out, err := exec.Command("ps", "-axo", "pid,user,rss,%cpu,comm").Output()
+    if err != nil {
+        return nil, fmt.Errorf("sysinfo: ps: %w", err)
+    }
+    var procs []ProcessInfo
+    lines := strings.Split(string(out), "\n")
+    for _, line := range lines[1:] {
+        f := strings.Fields(line)
+        if len(f) < 5 {
+            continue
+        }
+        pid, err := strconv.Atoi(f[0])
+        if err != nil {
+            continue
+        }
+        rssKB, _ := strconv.ParseUint(f[2], 10, 64)
+        cpu, _ := strconv.ParseFloat(f[3], 64)
+        procs = append(procs, ProcessInfo{
+            PID:    pid,
+            User:   f[1],
+            RSS:    rssKB * 1024,
+            CPUPct: cpu,
+            Name:   strings.Join(f[4:], " "),
+        })
+    }
+    return procs, nil
+}
@@ -0,0 +1,269 @@
+//go:build linux
+
+package sysinfo
+
+import (
+    "bufio"
+    "fmt"
+    "net"
+    "os"
+    "os/exec"
+    "runtime"
+    "strconv"
+    "strings"
+    "syscall"
+    "time"
+)
+
+type linuxCollector struct{}
+
+func newCollector() Collector { return linuxCollector{} }
+
+// OS reads /etc/os-release and `uname -r`.
+func (linuxCollector) OS() (OSInfo, error) {
+    info := OSInfo{"unknown", "unknown", "unknown"}
+    if fr, err := os.Open("/etc/os-release"); err == nil {
+        defer fr.Close()
+        scanner := bufio.NewScanner(fr)
+        for scanner.Scan() {
+            line := scanner.Text()
+            if strings.HasPrefix(line, "PRETTY_NAME=") {
+                parts := strings.SplitN(line, "=", 2)
+                info.Name = strings.Trim(parts[1], `"'`)
+            }
+            if strings.HasPrefix(line, "VERSION_ID=") {
+                parts := strings.SplitN(line, "=", 2)
+                info.Version = strings.Trim(parts[1], `"'`)
+            }
+        }
+    }
+    if k, err := exec.Command("uname", "-r").Output(); err == nil {
+        info.Kernel = strings.TrimSpace(string(k))
+    }
+    return info, nil
+}
+
+// CPU parses /proc/cpuinfo for CPU details.
+func (c linuxCollector) CPU() (CPUInfo, error) {
+    info := CPUInfo{}
+    info.Threads = runtime.NumCPU()
+    file, err := os.Open("/proc/cpuinfo")
+    if err != nil {
+        return info, nil
+    }
+    defer file.Close()
+
+    physCores := map[string]bool{}
+    scanner := bufio.NewScanner(file)
+    for scanner.Scan() {
+        line := scanner.Text()
+        if !strings.Contains(line, ":") {
+            continue
+        }
+        parts := strings.SplitN(line, ":", 2)
+        key := strings.TrimSpace(parts[0])
+        val := strings.TrimSpace(parts[1])
+
+        switch key {
+        case "vendor_id":
+            info.VendorID = val
+        case "model name":
+            info.ModelName = val
+        case "cpu family":
+            info.Family = val
+        case "model":
+            info.Model = val
+        case "stepping":
+            info.Stepping = val
+        case "cpu MHz":
+            if f, err := strconv.ParseFloat(val, 64); err == nil {
+                info.MHz = f
+            }
+        case "flags":
+            info.Flags = strings.Fields(val)
+        case "core id":
+            phys := ""
+            if parts := strings.Split(val, " "); len(parts) > 0 {
+                phys = parts[0]
+            }
+            physCores[phys] = true
+        }
+    }
+    if len(physCores) > 0 {
+        info.Cores = len(physCores)
+    }
+    if cores, ok := cgroupCPUQuota(); ok {
+        info.EffectiveCores = cores
+    } else {
+        info.EffectiveCores = info.Threads
+    }
+    return info, nil
+}
+
+// Mem parses /proc/meminfo for memory stats.
+func (linuxCollector) Mem() (MemInfo, error) {
+    m := MemInfo{}
+    file, err := os.Open("/proc/meminfo")
+    if err != nil {
+        return m, nil
+    }
+    defer file.Close()
+
+    parseKB := func(s string) uint64 {
+        v, _ := strconv.ParseUint(s, 10, 64)
+        return v * 1024
+    }
+
+    scanner := bufio.NewScanner(file)
+    for scanner.Scan() {
+        f := strings.Fields(scanner.Text())
+        if len(f) < 2 {
+            continue
+        }
+        key := strings.TrimSuffix(f[0], ":")
+        val := parseKB(f[1])
+        switch key {
+        case "MemTotal":
+            m.Total = val
+        case "MemFree":
+            m.Free = val
+        case "MemAvailable":
+            m.Available = val
+        case "Buffers":
+            m.Buffers = val
+        case "Cached":
+            m.Cached = val
+        case "SwapTotal":
+            m.SwapTotal = val
+        case "SwapFree":
+            m.SwapFree = val
+        }
+    }
+    if limit, ok := cgroupMemLimit(); ok {
+        m.EffectiveLimit = limit
+    } else {
+        m.EffectiveLimit = m.Total
+    }
+    return m, nil
+}
+
+// Disks inspects mounted filesystems via /proc/mounts.
+func (linuxCollector) Disks() ([]DiskInfo, error) {
+    var res []DiskInfo
+    file, err := os.Open("/proc/mounts")
+    if err != nil {
+        return res, nil
+    }
+    defer file.Close()
+
+    scanner := bufio.NewScanner(file)
+    seen := map[string]bool{}
+    for scanner.Scan() {
+        f := strings.Fields(scanner.Text())
+        if len(f) < 3 {
+            continue
+        }
+        mount, fs := f[1], f[2]
+        if seen[mount] || fs == "tmpfs" || fs == "proc" || fs == "sysfs" {
+            continue
+        }
+        seen[mount] = true
+        var st syscall.Statfs_t
+        if err := syscall.Statfs(mount, &st); err != nil {
+            continue
+        }
+        d := DiskInfo{
+            MountPoint: mount,
+            FsType:     fs,
+            Total:      st.Blocks * uint64(st.Bsize),
+            Free:       st.Bfree * uint64(st.Bsize),
+            Available:  st.Bavail * uint64(st.Bsize),
+        }
+        fillSmartHealth(&d)
+        res = append(res, d)
+    }
+    return res, nil
+}
+
+// Net enumerates network interfaces and addresses.
+func (linuxCollector) Net() ([]NetInfo, error) {
+    var out []NetInfo
+    ifs, err := net.Interfaces()
+    if err != nil {
+        return out, nil
+    }
+    for _, iface := range ifs {
+        if (iface.Flags & net.FlagUp) == 0 {
+            continue
+        }
+        var addrs []string
+        if al, err := iface.Addrs(); err == nil {
+            for _, a := range al {
+                addrs = append(addrs, a.String())
+            }
+        }
+        out = append(out, NetInfo{
+            Name:         iface.Name,
+            HardwareAddr: iface.HardwareAddr.String(),
+            Addrs:        addrs,
+        })
+    }
+    return out, nil
+}
+
+// UptimeLoad reads /proc/uptime and /proc/loadavg.
+func (linuxCollector) UptimeLoad() (UptimeLoad, error) {
+    ul := UptimeLoad{}
+    if data, err := os.ReadFile("/proc/uptime"); err == nil {
+        fields := strings.Fields(string(data))
+        if secs, err := strconv.ParseFloat(fields[0], 64); err == nil {
+            ul.Uptime = time.Duration(secs) * time.Second
+        }
+    }
+    if data, err := os.ReadFile("/proc/loadavg"); err == nil {
+        fields := strings.Fields(string(data))
+        if f1, err := strconv.ParseFloat(fields[0], 64); err == nil {
+            ul.Load1 = f1
+        }
+        if f5, err := strconv.ParseFloat(fields[1], 64); err == nil {
+            ul.Load5 = f5
+        }
+        if f15, err := strconv.ParseFloat(fields[2], 64); err == nil {
+            ul.Load15 = f15
+        }
+    }
+    return ul, nil
+}
+
+// Processes walks /proc/<pid> to list running processes.
+func (linuxCollector) Processes() ([]ProcessInfo, error) {
+    entries, err := os.ReadDir("/proc")
+    if err != nil {
+        return nil, fmt.Errorf("sysinfo: read /proc: %w", err)
+    }
+    var procs []ProcessInfo
+    for _, e := range entries {
+        pid, err := strconv.Atoi(e.Name())
+        if err != nil {
+            continue
+        }
+        comm, err := os.ReadFile(fmt.Sprintf("/proc/%d/comm", pid))
+        if err != nil {
+            continue
+        }
+        status, _ := os.ReadFile(fmt.Sprintf("/proc/%d/status", pid))
+        p := ProcessInfo{PID: pid, Name: strings.TrimSpace(string(comm))}
+        for _, line := range strings.Split(string(status), "\n") {
+            if strings.HasPrefix(line, "VmRSS:") {
+                f := strings.Fields(line)
+                if len(f) >= 2 {
+                    if kb, err := strconv.ParseUint(f[1], 10, 64); err == nil {
+                        p.RSS = kb * 1024
+                    }
+                }
+            }
+        }
+        procs = append(procs, p)
+    }
+    return procs, nil
+}
@@ -0,0 +1,194 @@
+//go:build windows
+
+package sysinfo
+
+import (
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "os/exec"
+    "reflect"
+    "strconv"
+    "strings"
+    "time"
+)
+
+type windowsCollector struct{}
+
+func newCollector() Collector { return windowsCollector{} }
+
+// wmiQuery runs a CIM query through PowerShell and decodes the JSON
+// result into v, a pointer to a slice. This avoids a cgo dependency on
+// the WMI/COM APIs while still going through the same WMI provider PDH
+// tooling uses.
+//
+// ConvertTo-Json emits a bare object, not a one-element array, when the
+// query matches exactly one instance (the common case for singleton
+// classes like Win32_OperatingSystem, and for Win32_Processor on any
+// single-socket box), so a plain array unmarshal into v is wrapped with
+// a single-object fallback.
+func wmiQuery(query string, v interface{}) error {
+    cmd := exec.Command("powershell", "-NoProfile", "-Command",
+        fmt.Sprintf("Get-CimInstance -Query '%s' | ConvertTo-Json", query))
+    out, err := cmd.Output()
+    if err != nil {
+        return fmt.Errorf("sysinfo: wmi query %q: %w", query, err)
+    }
+    if bytes.HasPrefix(bytes.TrimSpace(out), []byte("[")) {
+        return json.Unmarshal(out, v)
+    }
+
+    slice := reflect.ValueOf(v).Elem()
+    elem := reflect.New(slice.Type().Elem())
+    if err := json.Unmarshal(out, elem.Interface()); err != nil {
+        return err
+    }
+    slice.Set(reflect.Append(slice, elem.Elem()))
+    return nil
+}
+
+// OS queries Win32_OperatingSystem for name, version, and build.
+func (windowsCollector) OS() (OSInfo, error) {
+    var rows []struct {
+        Caption string
+        Version string
+        BuildNumber string
+    }
+    if err := wmiQuery("SELECT Caption, Version, BuildNumber FROM Win32_OperatingSystem", &rows); err != nil || len(rows) == 0 {
+        return OSInfo{Name: "Windows", Version: "unknown", Kernel: "unknown"}, nil
+    }
+    return OSInfo{Name: rows[0].Caption, Version: rows[0].Version, Kernel: rows[0].BuildNumber}, nil
+}
+
+// CPU queries Win32_Processor for core/thread counts and clock speed.
+func (windowsCollector) CPU() (CPUInfo, error) {
+    var rows []struct {
+        Name                      string
+        NumberOfCores             int
+        NumberOfLogicalProcessors int
+        MaxClockSpeed             float64
+    }
+    if err := wmiQuery("SELECT Name, NumberOfCores, NumberOfLogicalProcessors, MaxClockSpeed FROM Win32_Processor", &rows); err != nil || len(rows) == 0 {
+        return CPUInfo{}, nil
+    }
+    r := rows[0]
+    return CPUInfo{
+        ModelName:      strings.TrimSpace(r.Name),
+        Cores:          r.NumberOfCores,
+        Threads:        r.NumberOfLogicalProcessors,
+        MHz:            r.MaxClockSpeed,
+        EffectiveCores: r.NumberOfLogicalProcessors,
+    }, nil
+}
+
+// Mem queries Win32_OperatingSystem for physical and virtual memory.
+func (windowsCollector) Mem() (MemInfo, error) {
+    var rows []struct {
+        TotalVisibleMemorySize uint64 // KB
+        FreePhysicalMemory     uint64 // KB
+        TotalVirtualMemorySize uint64 // KB
+        FreeVirtualMemory      uint64 // KB
+    }
+    if err := wmiQuery("SELECT TotalVisibleMemorySize, FreePhysicalMemory, TotalVirtualMemorySize, FreeVirtualMemory FROM Win32_OperatingSystem", &rows); err != nil || len(rows) == 0 {
+        return MemInfo{}, nil
+    }
+    r := rows[0]
+    total := r.TotalVisibleMemorySize * 1024
+    return MemInfo{
+        Total:          total,
+        Free:           r.FreePhysicalMemory * 1024,
+        Available:      r.FreePhysicalMemory * 1024,
+        SwapTotal:      (r.TotalVirtualMemorySize - r.TotalVisibleMemorySize) * 1024,
+        SwapFree:       r.FreeVirtualMemory * 1024,
+        EffectiveLimit: total,
+    }, nil
+}
+
+// Disks queries Win32_LogicalDisk for fixed-drive capacity.
+func (windowsCollector) Disks() ([]DiskInfo, error) {
+    var rows []struct {
+        DeviceID  string
+        FileSystem string
+        Size      uint64
+        FreeSpace uint64
+    }
+    if err := wmiQuery("SELECT DeviceID, FileSystem, Size, FreeSpace FROM Win32_LogicalDisk WHERE DriveType=3", &rows); err != nil {
+        return nil, nil
+    }
+    res := make([]DiskInfo, 0, len(rows))
+    for _, r := range rows {
+        res = append(res, DiskInfo{
+            MountPoint: r.DeviceID,
+            FsType:     r.FileSystem,
+            Total:      r.Size,
+            Free:       r.FreeSpace,
+            Available:  r.FreeSpace,
+        })
+    }
+    return res, nil
+}
+
+// Net queries Win32_NetworkAdapterConfiguration for interface addresses.
+func (windowsCollector) Net() ([]NetInfo, error) {
+    var rows []struct {
+        Description string
+        MACAddress  string
+        IPAddress   []string
+    }
+    if err := wmiQuery("SELECT Description, MACAddress, IPAddress FROM Win32_NetworkAdapterConfiguration WHERE IPEnabled=True", &rows); err != nil {
+        return nil, nil
+    }
+    res := make([]NetInfo, 0, len(rows))
+    for _, r := range rows {
+        res = append(res, NetInfo{Name: r.Description, HardwareAddr: r.MACAddress, Addrs: r.IPAddress})
+    }
+    return res, nil
+}
+
+// UptimeLoad derives uptime from Win32_OperatingSystem's LastBootUpTime;
+// Windows has no direct load-average equivalent, so Load1/5/15 stay 0.
+func (windowsCollector) UptimeLoad() (UptimeLoad, error) {
+    var rows []struct{ LastBootUpTime string }
+    if err := wmiQuery("SELECT LastBootUpTime FROM Win32_OperatingSystem", &rows); err != nil || len(rows) == 0 {
+        return UptimeLoad{}, nil
+    }
+    boot, err := parseWMIDateTime(rows[0].LastBootUpTime)
+    if err != nil {
+        return UptimeLoad{}, nil
+    }
+    return UptimeLoad{Uptime: time.Since(boot)}, nil
+}
+
+// parseWMIDateTime parses a WMI DMTF datetime string, e.g.
+// "20240101120000.000000-300": a standard "20060102150405.000000"
+// timestamp followed by the UTC offset as a signed 3-digit count of
+// minutes (not Go's signed-4-digit-hour+minute "-0700" layout, which
+// can't parse a 3-digit field like "-300" at all).
+func parseWMIDateTime(s string) (time.Time, error) {
+    if len(s) != 25 {
+        return time.Time{}, fmt.Errorf("sysinfo: invalid WMI datetime %q", s)
+    }
+    offMin, err := strconv.Atoi(s[21:])
+    if err != nil {
+        return time.Time{}, fmt.Errorf("sysinfo: invalid WMI UTC offset in %q: %w", s, err)
+    }
+    loc := time.FixedZone("", offMin*60)
+    return time.ParseInLocation("20060102150405.000000", s[:21], loc)
+}
+
+// Processes queries Win32_Process for the running process table.
+func (windowsCollector) Processes() ([]ProcessInfo, error) {
+    var rows []struct {
+        ProcessId   int
+        Name        string
+        WorkingSetSize uint64
+    }
+    if err := wmiQuery("SELECT ProcessId, Name, WorkingSetSize FROM Win32_Process", &rows); err != nil {
+        return nil, fmt.Errorf("sysinfo: wmi process query: %w", err)
+    }
+    procs := make([]ProcessInfo, 0, len(rows))
+    for _, r := range rows {
+        procs = append(procs, ProcessInfo{PID: r.ProcessId, Name: r.Name, RSS: r.WorkingSetSize})
+    }
+    return procs, nil
+}